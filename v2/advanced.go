@@ -9,6 +9,34 @@ type KeyValuePair[K comparable, V any] struct {
 	value V
 }
 
+// Creates a key-value pair.
+func NewKeyValuePair[K comparable, V any](key K, value V) KeyValuePair[K, V] {
+	return KeyValuePair[K, V]{key, value}
+}
+
+// Gets the key of the pair.
+func (pair KeyValuePair[K, V]) Key() K {
+	return pair.key
+}
+
+// Gets the value of the pair.
+func (pair KeyValuePair[K, V]) Value() V {
+	return pair.value
+}
+
+// Pair is a tuple of two values of any types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a tuple of three values of any types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
 type Iteratee[E any, V any] func(E) V
 
 type Predicate[E any] func(E) bool
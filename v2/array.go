@@ -2,37 +2,56 @@ package godash
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 )
 
 // Creates an array of elements split into groups the length of size. If array can't be split evenly,
-// the final chunk will be the remaining elements.
+// the final chunk will be the remaining elements. An empty array is returned when size is not positive.
+// The chunks are copies of items, so modifying them does not affect items.
 func Chunk[E any](items []E, size int) [][]E {
-	dashSlices := [][]E{}
+	copied := make([]E, len(items))
+	copy(copied, items)
 
-	for _, item := range items {
-		sliceLength := len(dashSlices)
-		if sliceLength == 0 || len(dashSlices[sliceLength-1]) == size {
-			dashSlices = append(dashSlices, []E{})
-			sliceLength++
+	return ChunkView(copied, size)
+}
+
+// This method is like Chunk except that the chunks are views of items without copying.
+// Modifying an element of a chunk modifies items, but appending to a chunk never overwrites the next chunk.
+func ChunkView[E any](items []E, size int) [][]E {
+	if size <= 0 {
+		return [][]E{}
+	}
+
+	length := len(items)
+	dashSlices := make([][]E, 0, (length+size-1)/size)
+	for start := 0; start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
 		}
 
-		dashSlices[sliceLength-1] = append(dashSlices[sliceLength-1], item)
+		dashSlices = append(dashSlices, items[start:end:end])
 	}
 
 	return dashSlices
 }
 
+// This method is like Chunk except that it returns ErrInvalidSize when size is not positive.
+func ChunkChecked[E any](items []E, size int) ([][]E, error) {
+	if size <= 0 {
+		return nil, invalidSizeError("chunk size", size)
+	}
+
+	return Chunk(items, size), nil
+}
+
 // Creates an array with all falsy values removed. The values false, 0, "", nil are falsy.
+// Other zero values, such as nil pointers, 0.0 or int8(0), are kept.
 func Compact[E any](items []E) []E {
 	dashSlice := []E{}
 
 	for _, item := range items {
-		if !reflect.DeepEqual(item, nil) &&
-			!reflect.DeepEqual(item, false) &&
-			!reflect.DeepEqual(item, 0) &&
-			!reflect.DeepEqual(item, "") {
+		if !isCompactFalsy(item) {
 			dashSlice = append(dashSlice, item)
 		}
 	}
@@ -40,21 +59,25 @@ func Compact[E any](items []E) []E {
 	return dashSlice
 }
 
+func isCompactFalsy(item any) bool {
+	return item == nil || item == false || item == 0 || item == ""
+}
+
 // Creates a new array concatenating array with any additional arrays and/or values.
 func Concat[E any](items []E, newItems []E) []E {
-	result := append([]E{}, items...)
-
-	result = append(result, newItems...)
-
-	return result
+	return ConcatSlices(items, newItems)
 }
 
 // Creates a new array concatenating array with any additional DashSlices.
 func ConcatSlices[E any](slices ...[]E) []E {
-	result := []E{}
+	length := 0
+	for _, slice := range slices {
+		length += len(slice)
+	}
 
+	result := make([]E, 0, length)
 	for _, slice := range slices {
-		result = Concat(result, slice)
+		result = append(result, slice...)
 	}
 
 	return result
@@ -64,9 +87,10 @@ func ConcatSlices[E any](slices ...[]E) []E {
 // The order and references of result values are determined by the first array.
 func Difference[E any](items []E, itemsToCompare []E) []E {
 	var result = []E{}
+	excluded := newHashSet(itemsToCompare...)
 
 	for _, item := range items {
-		if _, ok := IndexOf(itemsToCompare, item); !ok {
+		if !excluded.has(item) {
 			result = append(result, item)
 		}
 	}
@@ -83,9 +107,10 @@ func DifferenceBy[E any](items []E, itemsToCompare []E, iteratee Iteratee[E, E])
 	itemsToCompareNew := Map(itemsToCompare, iteratee)
 
 	var result = []E{}
+	excluded := newHashSet(itemsToCompareNew...)
 
 	for i, item := range itemsNew {
-		if _, ok := IndexOf(itemsToCompareNew, item); !ok {
+		if !excluded.has(item) {
 			result = append(result, items[i])
 		}
 	}
@@ -152,39 +177,42 @@ func DropWhile[E any](items []E, predicate Predicate[E]) []E {
 	return result
 }
 
-// This method is like _.find except that it returns the index of the first element predicate returns truthy
-// for instead of the element itself.
+// Gets the index at which the first occurrence of element is found in array using SameValueZero for equality
+// comparisons, so NaN matches NaN and +0 matches -0.
 func IndexOf[E any](items []E, element E) (int, bool) {
-	var index = -1
-	var ok bool
-
-	for i, el := range items {
-		if reflect.DeepEqual(el, element) {
-			index = i
-			ok = true
-			break
+	equal := equalTo(element)
+	for i := range items {
+		if equal(&items[i]) {
+			return i, true
 		}
 	}
 
-	return index, ok
+	return -1, false
 }
 
 // Fills elements of array with value from start up to, but not including end.
+// Negative start and end are offsets from the end of array. Both are clamped to the array bounds.
 func FillInRange[E any](items []E, value E, start int, end int) {
-	if start < 0 {
-		start = 0
-	}
-
 	length := len(items)
-	if end >= length {
-		end = length
-	}
+	start = clampIndex(start, length)
+	end = clampIndex(end, length)
 
 	for i := start; i < end; i++ {
 		items[i] = value
 	}
 }
 
+// This method is like FillInRange except that it returns ErrOutOfRange when start or end is out of the array bounds,
+// and ErrInvalidSize when start is after end.
+func FillInRangeChecked[E any](items []E, value E, start int, end int) error {
+	start, end, err := checkRange(len(items), start, end)
+	if err == nil {
+		FillInRange(items, value, start, end)
+	}
+
+	return err
+}
+
 // Fills elements of array with value.
 func Fill[E any](items []E, fillValue E) {
 	FillInRange(items, fillValue, 0, len(items))
@@ -223,23 +251,14 @@ func FindIndex[E any](items []E, predicate Predicate[E]) (int, bool) {
 
 // This method is like IndexOf except that it iterates over elements of array from right to left.
 func LastIndexOf[E any](items []E, element E) (int, bool) {
-	var index = -1
-	var ok bool
-	length := len(items)
-
-	reversed := make([]E, length)
-	copy(reversed, items)
-	Reverse(reversed)
-
-	for i, el := range reversed {
-		if reflect.DeepEqual(el, element) {
-			index = length - i - 1
-			ok = true
-			break
+	equal := equalTo(element)
+	for i := len(items) - 1; i >= 0; i-- {
+		if equal(&items[i]) {
+			return i, true
 		}
 	}
 
-	return index, ok
+	return -1, false
 }
 
 // This method is like FindIndex except that it iterates over elements of collection from right to left.
@@ -281,6 +300,7 @@ func FindLastIndex[E any](items []E, predicate Predicate[E]) (int, bool) {
 }
 
 // Reverses array so that the first element becomes the last, the second element becomes the second to last, and so on.
+// Array is modified in place; use ReverseAppend to keep it unchanged.
 func Reverse[E any](items []E) []E {
 	length := len(items)
 
@@ -333,17 +353,24 @@ func FromPairs[K comparable, V any](pairs []KeyValuePair[K, V]) map[K]V {
 	return result
 }
 
+// Creates an array of values by running each element in collection thru iteratee.
+// The iteratee is invoked with one argument: (value).
 func Map[E, V any](slice []E, iteratee func(E) V) []V {
-	result := []V{}
-	for _, item := range slice {
-		result = append(result, iteratee(item))
+	result := make([]V, len(slice))
+	for i, item := range slice {
+		result[i] = iteratee(item)
 	}
 
 	return result
 }
 
+const filterCapacity = 1024
+
+// Iterates over elements of collection, returning an array of all elements predicate returns truthy for.
+// The result is preallocated with the length of collection, up to 1024 elements, and grows beyond that.
+// The predicate is invoked with one argument: (value).
 func Filter[E any](slice []E, predicate Predicate[E]) []E {
-	result := []E{}
+	result := make([]E, 0, min(len(slice), filterCapacity))
 	for _, item := range slice {
 		if predicate(item) {
 			result = append(result, item)
@@ -369,9 +396,10 @@ func Initial[E any](slice []E) []E {
 // The order and references of result values are determined by the first array.
 func Intersection[E any](items1 []E, items2 []E) (intersectedItems []E) {
 	intersectedItems = []E{}
+	included := newHashSet(items2...)
 
 	for _, item := range items1 {
-		if _, ok := IndexOf(items2, item); ok {
+		if included.has(item) {
 			intersectedItems = append(intersectedItems, item)
 		}
 	}
@@ -385,10 +413,10 @@ func Intersection[E any](items1 []E, items2 []E) (intersectedItems []E) {
 func IntersectionBy[E, T any](items1 []E, items2 []E, iteratee Iteratee[E, T]) (intersectedItems []E) {
 	intersectedItems = []E{}
 	newItems1 := Map(items1, iteratee)
-	newItems2 := Map(items2, iteratee)
+	included := newHashSet(Map(items2, iteratee)...)
 
 	for i, item := range newItems1 {
-		if _, ok := IndexOf(newItems2, item); ok {
+		if included.has(item) {
 			intersectedItems = append(intersectedItems, items1[i])
 		}
 	}
@@ -450,23 +478,38 @@ func Nth[E any](items []E, n int) (exists bool, item E) {
 	}
 }
 
+// This method is like Nth except that it returns ErrOutOfRange when n is out of the array bounds.
+func NthChecked[E any](items []E, n int) (item E, err error) {
+	length := len(items)
+	if n >= length || n < -length {
+		err = outOfRangeError(n, length)
+		return
+	}
+
+	_, item = Nth(items, n)
+	return
+}
+
 // Removes all given values from array using SameValueZero for equality comparisons.
+// Array is modified; use Without for a non-mutating version.
 func Pull[E comparable](items *[]E, values ...E) []E {
-	comparison := func(i1 E, i2 E) bool {
-		return i1 == i2
-	}
+	excluded := newHashSet(values...)
+	*items = Filter(*items, func(item E) bool {
+		return !excluded.has(item)
+	})
 
-	result := PullAllWith(items, values, comparison)
-	return result
+	return *items
 }
 
 // This method is like Pull except that it accepts an array of values to remove.
+// Array is modified; use WithoutAll for a non-mutating version.
 func PullAll[E comparable](items *[]E, values []E) []E {
 	return Pull(items, values...)
 }
 
 // This method is like PullAll except that it accepts comparator which is invoked to compare elements of array to values.
 // The comparator is invoked with two arguments: (arrVal, othVal).
+// Array is modified; use WithoutWith for a non-mutating version.
 func PullAllWith[E any](items *[]E, values []E, comparison Comparison[E]) []E {
 	result := []E{}
 
@@ -480,9 +523,19 @@ func PullAllWith[E any](items *[]E, values []E, comparison Comparison[E]) []E {
 	return result
 }
 
-//Removes elements from array corresponding to indexes and returns an array of removed elements.
+// Removes elements from array corresponding to indexes and returns an array of removed elements.
+// Negative indexes are offsets from the end of array. Out of range indexes are ignored.
+// Array is modified; use WithoutAt for a non-mutating version.
 func PullAt[E any](items *[]E, indices ...int) (pulled []E) {
 	result := []E{}
+	length := len(*items)
+	indices = Map(indices, func(i int) int {
+		if i < 0 {
+			i += length
+		}
+
+		return i
+	})
 
 	for i, item := range *items {
 		if _, ok := IndexOf(indices, i); ok {
@@ -496,8 +549,22 @@ func PullAt[E any](items *[]E, indices ...int) (pulled []E) {
 	return pulled
 }
 
+// This method is like PullAt except that it returns ErrOutOfRange when an index is out of the array bounds.
+// Array is not modified when an error is returned.
+func PullAtChecked[E any](items *[]E, indices ...int) ([]E, error) {
+	length := len(*items)
+	for _, index := range indices {
+		if index >= length || index < -length {
+			return nil, outOfRangeError(index, length)
+		}
+	}
+
+	return PullAt(items, indices...), nil
+}
+
 // Removes all elements from array that predicate returns truthy for and returns an array of the removed elements.
-// The predicate is invoked with two arguments: (value, index).
+// The predicate is invoked with one argument: (value).
+// Array is modified; use Reject for a non-mutating version.
 func Remove[E any](items *[]E, predicate Predicate[E]) (removed []E) {
 	newItems := []E{}
 
@@ -514,19 +581,48 @@ func Remove[E any](items *[]E, predicate Predicate[E]) (removed []E) {
 }
 
 // Creates a slice of array from start up to, but not including, end.
+// Negative start and end are offsets from the end of array. Both are clamped to the array bounds,
+// and an empty slice is returned when start is not before end.
 func Slice[E any](items []E, start int, end int) []E {
-	if start < 0 {
-		start = 0
-	}
-
 	length := len(items)
-	if end > length {
-		end = length
+	start = clampIndex(start, length)
+	end = clampIndex(end, length)
+
+	if start >= end {
+		return []E{}
 	}
 
 	return items[start:end]
 }
 
+func checkRange(length int, start int, end int) (int, int, error) {
+	start, err := checkIndex(start, length)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if end, err = checkIndex(end, length); err != nil {
+		return 0, 0, err
+	}
+
+	if start > end {
+		return 0, 0, fmt.Errorf("%w: start %d is after end %d", ErrInvalidSize, start, end)
+	}
+
+	return start, end, nil
+}
+
+// This method is like Slice except that it returns ErrOutOfRange when start or end is out of the array bounds,
+// and ErrInvalidSize when start is after end.
+func SliceChecked[E any](items []E, start int, end int) ([]E, error) {
+	start, end, err := checkRange(len(items), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return items[start:end], nil
+}
+
 // Gets all but the first element of array.
 func Tail[E any](items []E) (result []E) {
 	if len(items) > 0 {
@@ -538,17 +634,31 @@ func Tail[E any](items []E) (result []E) {
 	return
 }
 
-// Creates a slice of array with n elements taken from the beginning.
+// Creates a slice of array with n elements taken from the beginning. n is clamped to [0, len(items)].
 func Take[E any](items []E, n int) (results []E) {
 	length := len(items)
 	if n > length {
 		n = length
+	} else if n < 0 {
+		n = 0
 	}
 
 	results = items[0:n]
 	return
 }
 
+// This method is like Take except that it returns ErrInvalidSize when n is negative
+// and ErrOutOfRange when n is greater than the array length.
+func TakeChecked[E any](items []E, n int) ([]E, error) {
+	if n < 0 {
+		return nil, invalidSizeError("count", n)
+	} else if n > len(items) {
+		return nil, outOfRangeError(n, len(items))
+	}
+
+	return items[0:n], nil
+}
+
 // Creates a slice of array with elements taken from the beginning. Elements are taken until predicate returns falsy.
 // The predicate is invoked with one argument: (value).
 func TakeWhile[E any](items []E, predicate Predicate[E]) []E {
@@ -565,11 +675,13 @@ func TakeWhile[E any](items []E, predicate Predicate[E]) []E {
 	return Take(items, to)
 }
 
-// Creates a slice of array with n elements taken from the end.
+// Creates a slice of array with n elements taken from the end. n is clamped to [0, len(items)].
 func TakeRight[E any](items []E, n int) []E {
 	length := len(items)
 	if n >= length {
 		n = length
+	} else if n < 0 {
+		n = 0
 	}
 
 	from := length - n
@@ -592,7 +704,7 @@ func TakeRightWhile[E any](items []E, predicate Predicate[E]) []E {
 }
 
 // Creates an array of unique values, in order, from all given arrays using SameValueZero for equality comparisons.
-func Union[E comparable](slices ...[]E) []E {
+func Union[E any](slices ...[]E) []E {
 	result := ConcatSlices(slices...)
 	result = Uniq(result)
 	return result
@@ -601,7 +713,7 @@ func Union[E comparable](slices ...[]E) []E {
 // This method is like Uniq except that it accepts iteratee which is invoked for each element in array
 // to generate the criterion by which uniqueness is computed. The order of result values is determined
 // by the order they occur in the array. The iteratee is invoked with one argument: (value).
-func UnionBy[I any, O comparable](iteratee Iteratee[I, O], slices ...[]I) []I {
+func UnionBy[I any, O any](iteratee Iteratee[I, O], slices ...[]I) []I {
 	result := ConcatSlices(slices...)
 	result = UniqBy(result, iteratee)
 	return result
@@ -619,13 +731,12 @@ func UnionWith[E any](comparison Comparison[E], slices ...[]E) []E {
 // Creates a duplicate-free version of an array, using SameValueZero for equality comparisons,
 // in which only the first occurrence of each element is kept.
 // The order of result values is determined by the order they occur in the array.
-func Uniq[E comparable](items []E) []E {
-	uniqMarks := make(map[E]bool)
+func Uniq[E any](items []E) []E {
+	seen := newHashSet[E]()
 	result := []E{}
 
 	for _, item := range items {
-		if !uniqMarks[item] {
-			uniqMarks[item] = true
+		if seen.add(item) {
 			result = append(result, item)
 		}
 	}
@@ -636,14 +747,12 @@ func Uniq[E comparable](items []E) []E {
 // This method is like Union except that it accepts iteratee which is invoked for each element of each arrays
 // to generate the criterion by which uniqueness is computed. Result values are chosen from the first array
 // in which the value occurs. The iteratee is invoked with one argument: (value).
-func UniqBy[I any, O comparable](items []I, iteratee Iteratee[I, O]) []I {
-	uniqMarks := make(map[O]I)
+func UniqBy[I any, O any](items []I, iteratee Iteratee[I, O]) []I {
+	seen := newHashSet[O]()
 	result := []I{}
 
 	for _, item := range items {
-		newItem := iteratee(item)
-		if _, found := uniqMarks[newItem]; !found {
-			uniqMarks[newItem] = item
+		if seen.add(iteratee(item)) {
 			result = append(result, item)
 		}
 	}
@@ -720,19 +829,18 @@ func Zip[E any](slices ...[]E) [][]E {
 		}
 	}
 
-	result := make([][]E, 0)
-	for i := 0; i < maxLength; i++ {
-		item := []E{}
-		for _, slice := range slices {
+	width := len(slices)
+	values := make([]E, maxLength*width)
+	result := make([][]E, maxLength)
+	for i := range result {
+		item := values[i*width : (i+1)*width : (i+1)*width]
+		for j, slice := range slices {
 			if i < len(slice) {
-				item = append(item, slice[i])
-			} else {
-				var v E
-				item = append(item, v)
+				item[j] = slice[i]
 			}
 		}
 
-		result = append(result, item)
+		result[i] = item
 	}
 
 	return result
@@ -742,16 +850,94 @@ func Zip[E any](slices ...[]E) [][]E {
 // The iteratee is invoked with the elements of each group: (...group).
 func ZipWith[E any, V any](iteratee Iteratee[[]E, V], slices ...[]E) []V {
 	zipped := Zip(slices...)
+	return Map(zipped, iteratee)
+}
+
+// ZipLength specifies how Zip2 and Zip3 handle slices of different lengths.
+type ZipLength int
 
-	result := []V{}
-	for _, item := range zipped {
-		itemResult := iteratee(item)
-		result = append(result, itemResult)
+const (
+	// Zips up to the longest slice. Missing elements are zero values.
+	ZipLongest ZipLength = iota
+	// Zips up to the shortest slice. Extra elements are dropped.
+	ZipShortest
+)
+
+func zipLength(length ZipLength, lengths ...int) int {
+	result := lengths[0]
+	for _, l := range lengths[1:] {
+		if (length == ZipShortest) == (l < result) {
+			result = l
+		}
+	}
+
+	return result
+}
+
+func elementAt[E any](items []E, i int) (item E) {
+	if i < len(items) {
+		item = items[i]
+	}
+
+	return
+}
+
+// This method is like Zip except that it accepts slices of different element types and groups them into pairs.
+// The length specifies whether the result is padded with zero values or truncated when the slices are uneven.
+func Zip2[A, B any](as []A, bs []B, length ZipLength) []Pair[A, B] {
+	result := make([]Pair[A, B], zipLength(length, len(as), len(bs)))
+	for i := range result {
+		result[i] = Pair[A, B]{elementAt(as, i), elementAt(bs, i)}
+	}
+
+	return result
+}
+
+// This method is like Zip2 except that it groups three slices into triples.
+func Zip3[A, B, C any](as []A, bs []B, cs []C, length ZipLength) []Triple[A, B, C] {
+	result := make([]Triple[A, B, C], zipLength(length, len(as), len(bs), len(cs)))
+	for i := range result {
+		result[i] = Triple[A, B, C]{elementAt(as, i), elementAt(bs, i), elementAt(cs, i)}
 	}
 
 	return result
 }
 
+// This method is like Zip except that it accepts an array of grouped elements and creates an array
+// regrouping the elements to their pre-zip configuration.
+func Unzip[E any](zipped [][]E) [][]E {
+	return Zip(zipped...)
+}
+
+// The inverse of Zip2, it splits pairs into two slices.
+func Unzip2[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, pair := range pairs {
+		as[i], bs[i] = pair.First, pair.Second
+	}
+
+	return as, bs
+}
+
+// The inverse of Zip3, it splits triples into three slices.
+func Unzip3[A, B, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, len(triples))
+	bs := make([]B, len(triples))
+	cs := make([]C, len(triples))
+	for i, triple := range triples {
+		as[i], bs[i], cs[i] = triple.First, triple.Second, triple.Third
+	}
+
+	return as, bs, cs
+}
+
+// This method is like Unzip except that it accepts iteratee to specify how regrouped values should be combined.
+// The iteratee is invoked with the elements of each group: (...group).
+func UnzipWith[E any, V any](zipped [][]E, iteratee Iteratee[[]E, V]) []V {
+	return ZipWith(iteratee, zipped...)
+}
+
 // <-- later below ->
 //TODO: sortedIndex
 //TODO: sortedIndexBy
@@ -0,0 +1,179 @@
+package godash
+
+import (
+	"strconv"
+	"testing"
+)
+
+func benchmarkInts(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	return items
+}
+
+func BenchmarkMap(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Map(items, func(i int) int { return i * 2 })
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Filter(items, func(i int) bool { return i%2 == 0 })
+	}
+}
+
+func BenchmarkChunk(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Chunk(items, 16)
+	}
+}
+
+func BenchmarkChunkView(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		ChunkView(items, 16)
+	}
+}
+
+func BenchmarkConcatSlices(b *testing.B) {
+	slices := ChunkView(benchmarkInts(10000), 100)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		ConcatSlices(slices...)
+	}
+}
+
+func BenchmarkZipWith(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		ZipWith(func(group []int) string {
+			return strconv.Itoa(group[0] + group[1])
+		}, items, items)
+	}
+}
+
+func BenchmarkZip(b *testing.B) {
+	items := benchmarkInts(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Zip(items, items, items)
+	}
+}
+
+func benchmarkInt64s(n int) []int64 {
+	items := make([]int64, n)
+	for i := range items {
+		items[i] = int64(i % (n / 2))
+	}
+
+	return items
+}
+
+func BenchmarkIndexOf(b *testing.B) {
+	items := benchmarkInt64s(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		IndexOf(items, -1)
+	}
+}
+
+func BenchmarkLastIndexOf(b *testing.B) {
+	items := benchmarkInt64s(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		LastIndexOf(items, -1)
+	}
+}
+
+type benchmarkLabel string
+
+func benchmarkLabels(n int) []benchmarkLabel {
+	items := make([]benchmarkLabel, n)
+	for i := range items {
+		items[i] = benchmarkLabel("label-" + strconv.Itoa(i%(n/2)))
+	}
+
+	return items
+}
+
+// uniqMap is the plain map based Uniq, which Uniq is expected to match for plain types.
+func uniqMap[E comparable](items []E) []E {
+	marks := make(map[E]bool)
+	result := []E{}
+
+	for _, item := range items {
+		if !marks[item] {
+			marks[item] = true
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func BenchmarkUniqInt64(b *testing.B) {
+	items := benchmarkInt64s(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Uniq(items)
+	}
+}
+
+func BenchmarkUniqInt64Map(b *testing.B) {
+	items := benchmarkInt64s(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		uniqMap(items)
+	}
+}
+
+func BenchmarkUniqNamedString(b *testing.B) {
+	items := benchmarkLabels(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Uniq(items)
+	}
+}
+
+func BenchmarkUniqNamedStringMap(b *testing.B) {
+	items := benchmarkLabels(10000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		uniqMap(items)
+	}
+}
+
+func BenchmarkDifferenceInt64(b *testing.B) {
+	items := benchmarkInt64s(10000)
+	excluded := benchmarkInt64s(1000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Difference(items, excluded)
+	}
+}
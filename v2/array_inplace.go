@@ -0,0 +1,141 @@
+package godash
+
+// Sets the elements after length to zero values so they can be garbage collected, and shortens items to length.
+func clearTail[E any](items []E, length int) []E {
+	var zero E
+	for i := length; i < len(items); i++ {
+		items[i] = zero
+	}
+
+	return items[:length]
+}
+
+// This method is like Filter except that it filters items in place without allocating.
+// The backing array of items is reused and the shortened slice is returned, so items must not be used afterwards.
+// Elements after the new length are set to zero values so they can be garbage collected.
+func FilterInPlace[E any](items []E, predicate Predicate[E]) []E {
+	length := 0
+	for _, item := range items {
+		if predicate(item) {
+			items[length] = item
+			length++
+		}
+	}
+
+	return clearTail(items, length)
+}
+
+// This method is like Filter except that it appends the result to dst and returns the extended slice,
+// like the built-in append.
+func FilterAppend[E any](dst []E, items []E, predicate Predicate[E]) []E {
+	for _, item := range items {
+		if predicate(item) {
+			dst = append(dst, item)
+		}
+	}
+
+	return dst
+}
+
+// This method is like Map except that it appends the result to dst and returns the extended slice,
+// like the built-in append.
+func MapAppend[E, V any](dst []V, items []E, iteratee func(E) V) []V {
+	for _, item := range items {
+		dst = append(dst, iteratee(item))
+	}
+
+	return dst
+}
+
+// This method is like Uniq except that it removes duplicates in place. Like FilterInPlace, the backing array of
+// items is reused and the shortened slice is returned.
+func UniqInPlace[E any](items []E) []E {
+	seen := newHashSet[E]()
+	return FilterInPlace(items, func(item E) bool {
+		return seen.add(item)
+	})
+}
+
+// This method is like Uniq except that it appends the result to dst and returns the extended slice.
+// Elements already in dst are not taken into account.
+func UniqAppend[E any](dst []E, items []E) []E {
+	seen := newHashSet[E]()
+	return FilterAppend(dst, items, func(item E) bool {
+		return seen.add(item)
+	})
+}
+
+// This method is like Compact except that it removes falsy values in place. Like FilterInPlace, the backing array
+// of items is reused and the shortened slice is returned.
+func CompactInPlace[E any](items []E) []E {
+	return FilterInPlace(items, func(item E) bool {
+		return !isCompactFalsy(item)
+	})
+}
+
+// This method is like Compact except that it appends the result to dst and returns the extended slice.
+func CompactAppend[E any](dst []E, items []E) []E {
+	return FilterAppend(dst, items, func(item E) bool {
+		return !isCompactFalsy(item)
+	})
+}
+
+// This method is like Without except that it removes the values in place. Like FilterInPlace, the backing array
+// of items is reused and the shortened slice is returned.
+func WithoutInPlace[E comparable](items []E, values ...E) []E {
+	excluded := newHashSet(values...)
+	return FilterInPlace(items, func(item E) bool {
+		return !excluded.has(item)
+	})
+}
+
+// This method is like Without except that it appends the result to dst and returns the extended slice.
+func WithoutAppend[E comparable](dst []E, items []E, values ...E) []E {
+	excluded := newHashSet(values...)
+	return FilterAppend(dst, items, func(item E) bool {
+		return !excluded.has(item)
+	})
+}
+
+// Reverses items in place. It is the same as Reverse, named explicitly for the in-place family.
+func ReverseInPlace[E any](items []E) []E {
+	return Reverse(items)
+}
+
+// Appends the elements of items to dst in reverse order. items is not modified.
+func ReverseAppend[E any](dst []E, items []E) []E {
+	for i := len(items) - 1; i >= 0; i-- {
+		dst = append(dst, items[i])
+	}
+
+	return dst
+}
+
+// This method is like PullAll except that it creates a new array instead of modifying items.
+func WithoutAll[E comparable](items []E, values []E) []E {
+	return Without(items, values...)
+}
+
+// This method is like PullAllWith except that it creates a new array instead of modifying items.
+// The comparator is invoked with two arguments: (arrVal, othVal).
+func WithoutWith[E any](items []E, values []E, comparison Comparison[E]) []E {
+	return Filter(items, func(item E) bool {
+		_, found := FindIndexWith(values, item, comparison)
+		return !found
+	})
+}
+
+// This method is like PullAt except that it creates a new array instead of modifying items.
+func WithoutAt[E any](items []E, indices ...int) []E {
+	result := append([]E{}, items...)
+	PullAt(&result, indices...)
+	return result
+}
+
+// Creates an array of elements predicate returns falsy for. It is the non-mutating counterpart of Remove.
+// The predicate is invoked with one argument: (value).
+func Reject[E any](items []E, predicate Predicate[E]) []E {
+	return Filter(items, func(item E) bool {
+		return !predicate(item)
+	})
+}
@@ -0,0 +1,86 @@
+package godash
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	backing := items
+	result := FilterInPlace(items, func(i int) bool {
+		return i%2 == 1
+	})
+
+	assert.DeepEqual(t, result, []int{1, 3, 5})
+	assert.DeepEqual(t, backing, []int{1, 3, 5, 0, 0})
+	assert.Equal(t, &result[0], &backing[0])
+}
+
+func TestFilterAppend(t *testing.T) {
+	dst := make([]int, 0, 8)
+	dst = FilterAppend(dst, []int{1, 2, 3}, func(i int) bool { return i > 1 })
+	dst = FilterAppend(dst, []int{4, 5}, func(i int) bool { return i < 5 })
+
+	assert.DeepEqual(t, dst, []int{2, 3, 4})
+	assert.Equal(t, cap(dst), 8)
+}
+
+func TestMapAppend(t *testing.T) {
+	result := MapAppend([]string{"x"}, []string{"a", "b"}, strings.ToUpper)
+
+	assert.DeepEqual(t, result, []string{"x", "A", "B"})
+}
+
+func TestUniqInPlace(t *testing.T) {
+	items := []string{"a", "b", "a", "c", "b"}
+
+	assert.DeepEqual(t, UniqInPlace(items), []string{"a", "b", "c"})
+	assert.DeepEqual(t, UniqAppend([]string{"a"}, []string{"a", "b", "a"}), []string{"a", "a", "b"})
+}
+
+func TestCompactInPlace(t *testing.T) {
+	items := []any{"a", 0, false, nil, "", 1}
+
+	assert.DeepEqual(t, CompactInPlace(items), []any{"a", 1})
+	assert.DeepEqual(t, CompactAppend(nil, []any{0, "b"}), []any{"b"})
+}
+
+func TestWithoutInPlace(t *testing.T) {
+	items := []int{1, 2, 3, 1, 4}
+
+	assert.DeepEqual(t, WithoutInPlace(items, 1, 4), []int{2, 3})
+	assert.DeepEqual(t, WithoutAppend([]int{0}, []int{1, 2, 3}, 2), []int{0, 1, 3})
+}
+
+func TestReverseAppend(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	assert.DeepEqual(t, ReverseAppend(nil, items), []int{3, 2, 1})
+	assert.DeepEqual(t, items, []int{1, 2, 3})
+	assert.DeepEqual(t, ReverseInPlace(items), []int{3, 2, 1})
+	assert.DeepEqual(t, items, []int{3, 2, 1})
+}
+
+func TestNonMutatingTwins(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+
+	assert.DeepEqual(t, WithoutAll(items, []int{2, 3}), []int{1, 4})
+	assert.DeepEqual(t, WithoutWith(items, []int{3}, func(a, b int) bool { return a == b }), []int{1, 2, 4})
+	assert.DeepEqual(t, WithoutAt(items, 0, -1), []int{2, 3})
+	assert.DeepEqual(t, Reject(items, func(i int) bool { return i%2 == 0 }), []int{1, 3})
+	assert.DeepEqual(t, items, []int{1, 2, 3, 4})
+}
+
+func ExampleFilterInPlace() {
+	queue := []string{"done", "todo", "done", "todo"}
+	queue = FilterInPlace(queue, func(s string) bool {
+		return s != "done"
+	})
+	fmt.Println(queue, len(queue))
+	// Output:
+	// [todo todo] 2
+}
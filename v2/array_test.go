@@ -1,6 +1,7 @@
 package godash
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
@@ -50,6 +51,11 @@ func TestCompact(t *testing.T) {
 	compacted := Compact(items)
 
 	assert.DeepEqual(t, compacted, []interface{}{"a", "b", 1})
+
+	var nilPointer *int
+	kept := []any{nilPointer, int8(0), 0.0, []int(nil), "x"}
+	assert.DeepEqual(t, Compact(kept), kept)
+	assert.DeepEqual(t, CompactInPlace(append([]any{}, kept...)), kept)
 }
 
 func ExampleCompact() {
@@ -126,6 +132,58 @@ func TestIndexOf(t *testing.T) {
 	indexOfTemp(t, IndexOf[string])
 }
 
+type parity int
+
+func (p parity) Equal(other parity) bool {
+	return p%2 == other%2
+}
+
+func TestIndexOfKinds(t *testing.T) {
+	type label string
+
+	index, _ := IndexOf([]label{"a", "b"}, "b")
+	assert.Equal(t, index, 1)
+	index, _ = IndexOf([]int8{1, -1, 2}, -1)
+	assert.Equal(t, index, 1)
+	index, _ = LastIndexOf([]bool{true, false, true}, true)
+	assert.Equal(t, index, 2)
+	index, _ = LastIndexOf([]uint16{1, 2}, 3)
+	assert.Equal(t, index, -1)
+	index, _ = IndexOf([]parity{1, 2, 3}, 4)
+	assert.Equal(t, index, 1)
+	index, _ = LastIndexOf([]parity{1, 2, 3}, 5)
+	assert.Equal(t, index, 2)
+}
+
+func TestIndexOfCyclic(t *testing.T) {
+	m := map[string]any{}
+	m["self"] = m
+	s := []any{nil}
+	s[0] = s
+
+	index, ok := IndexOf([]any{1, m}, any(m))
+	assert.Equal(t, ok, true)
+	assert.Equal(t, index, 1)
+	index, _ = LastIndexOf([]any{s, 1}, any(s))
+	assert.Equal(t, index, 0)
+}
+
+func TestIndexOfUnexportedMapKeys(t *testing.T) {
+	a := equalHidden{map[equalKey]int{{1}: 1, {2}: 2}}
+	b := equalHidden{map[equalKey]int{{1}: 1, {2}: 2}}
+	c := equalHidden{map[equalKey]int{{1}: 1, {2}: 3}}
+
+	index, ok := IndexOf([]equalHidden{c, a}, b)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, index, 1)
+	index, _ = LastIndexOf([]equalHidden{a, c}, b)
+	assert.Equal(t, index, 0)
+
+	assert.Equal(t, len(Uniq([]equalHidden{a, c, b})), 2)
+	assert.Equal(t, len(Difference([]equalHidden{a, c}, []equalHidden{b})), 1)
+	assert.Equal(t, len(Intersection([]equalHidden{a, c}, []equalHidden{b})), 1)
+}
+
 func ExampleIndexOf() {
 	items := []string{"a", "b", "c", "d"}
 	result1, ok := IndexOf(items, "1")
@@ -482,6 +540,9 @@ func TestFilter(t *testing.T) {
 	})
 
 	assert.DeepEqual(t, results, []int{2, 4, 6})
+
+	sparse := Filter(make([]int, 10000), func(i int) bool { return false })
+	assert.Equal(t, cap(sparse), 1024)
 }
 
 func TestIntersection(t *testing.T) {
@@ -606,6 +667,26 @@ func TestPullAt(t *testing.T) {
 	assert.DeepEqual(t, results, []int{1, 3})
 }
 
+func TestPullAtNegative(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	results := PullAt(&items, -1, 10)
+
+	assert.DeepEqual(t, items, []int{1, 2, 3})
+	assert.DeepEqual(t, results, []int{4})
+}
+
+func TestPullAtChecked(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	_, err := PullAtChecked(&items, 0, 4)
+
+	assert.Assert(t, errors.Is(err, ErrOutOfRange))
+	assert.DeepEqual(t, items, []int{1, 2, 3, 4})
+
+	results, err := PullAtChecked(&items, -4)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, results, []int{1})
+}
+
 func TestRemove(t *testing.T) {
 	items := []int{1, 2, 3, 4}
 	results := Remove(&items, func(i int) bool {
@@ -625,8 +706,28 @@ func TestSlice(t *testing.T) {
 	results = Slice(items, 0, 5)
 	assert.DeepEqual(t, results, []int{1, 2, 3, 4})
 
-	results = Slice(items, -1, 2)
+	results = Slice(items, -3, -1)
+	assert.DeepEqual(t, results, []int{2, 3})
+
+	results = Slice(items, -10, 2)
 	assert.DeepEqual(t, results, []int{1, 2})
+
+	results = Slice(items, -1, 2)
+	assert.DeepEqual(t, results, []int{})
+}
+
+func TestSliceChecked(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+
+	results, err := SliceChecked(items, -3, 4)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, results, []int{2, 3, 4})
+
+	_, err = SliceChecked(items, 0, 5)
+	assert.Assert(t, errors.Is(err, ErrOutOfRange))
+
+	_, err = SliceChecked(items, 3, 1)
+	assert.Assert(t, errors.Is(err, ErrInvalidSize))
 }
 
 func TestTail(t *testing.T) {
@@ -759,3 +860,184 @@ func TestZipWith(t *testing.T) {
 
 	assert.DeepEqual(t, results, []string{"Romeo, male", "Juliet, female"})
 }
+
+func TestZip2(t *testing.T) {
+	names := []string{"Romeo", "Juliet", "Mercutio"}
+	ages := []int{16, 13}
+
+	assert.DeepEqual(t, Zip2(names, ages, ZipLongest), []Pair[string, int]{{"Romeo", 16}, {"Juliet", 13}, {"Mercutio", 0}})
+	assert.DeepEqual(t, Zip2(names, ages, ZipShortest), []Pair[string, int]{{"Romeo", 16}, {"Juliet", 13}})
+	assert.DeepEqual(t, Zip2(names, []int{}, ZipShortest), []Pair[string, int]{})
+}
+
+func ExampleZip2() {
+	pairs := Zip2([]string{"a", "b"}, []int{1, 2}, ZipLongest)
+	fmt.Println(pairs)
+	// Output:
+	// [{a 1} {b 2}]
+}
+
+func TestZip3(t *testing.T) {
+	triples := Zip3([]string{"a", "b"}, []int{1, 2, 3}, []bool{true}, ZipLongest)
+	assert.DeepEqual(t, triples, []Triple[string, int, bool]{{"a", 1, true}, {"b", 2, false}, {"", 3, false}})
+
+	triples = Zip3([]string{"a", "b"}, []int{1, 2, 3}, []bool{true}, ZipShortest)
+	assert.DeepEqual(t, triples, []Triple[string, int, bool]{{"a", 1, true}})
+}
+
+func TestUnzip(t *testing.T) {
+	zipped := Zip([]string{"a", "b"}, []string{"1", "2"})
+	assert.DeepEqual(t, Unzip(zipped), [][]string{{"a", "b"}, {"1", "2"}})
+
+	sums := UnzipWith([][]int{{1, 10}, {2, 20}}, func(group []int) int {
+		return group[0] + group[1]
+	})
+	assert.DeepEqual(t, sums, []int{3, 30})
+}
+
+func TestUnzip2(t *testing.T) {
+	names, ages := Unzip2(Zip2([]string{"Romeo", "Juliet"}, []int{16, 13}, ZipShortest))
+
+	assert.DeepEqual(t, names, []string{"Romeo", "Juliet"})
+	assert.DeepEqual(t, ages, []int{16, 13})
+}
+
+func TestUnzip3(t *testing.T) {
+	as, bs, cs := Unzip3([]Triple[string, int, bool]{{"a", 1, true}, {"b", 2, false}})
+
+	assert.DeepEqual(t, as, []string{"a", "b"})
+	assert.DeepEqual(t, bs, []int{1, 2})
+	assert.DeepEqual(t, cs, []bool{true, false})
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	assert.DeepEqual(t, Chunk([]int{1, 2}, 0), [][]int{})
+	assert.DeepEqual(t, Chunk([]int{1, 2}, -1), [][]int{})
+
+	_, err := ChunkChecked([]int{1, 2}, 0)
+	assert.Assert(t, errors.Is(err, ErrInvalidSize))
+
+	chunked, err := ChunkChecked([]int{1, 2, 3}, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, chunked, [][]int{{1, 2}, {3}})
+}
+
+func TestFillInRangeNegative(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	FillInRange(items, 0, -3, -1)
+	assert.DeepEqual(t, items, []int{1, 0, 0, 4})
+
+	err := FillInRangeChecked(items, 9, -1, 4)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, items, []int{1, 0, 0, 9})
+
+	err = FillInRangeChecked(items, 9, -5, 4)
+	assert.Assert(t, errors.Is(err, ErrOutOfRange))
+}
+
+func TestNthChecked(t *testing.T) {
+	items := []string{"a", "b"}
+
+	item, err := NthChecked(items, -2)
+	assert.NilError(t, err)
+	assert.Equal(t, item, "a")
+
+	_, err = NthChecked(items, 2)
+	assert.Assert(t, errors.Is(err, ErrOutOfRange))
+}
+
+func TestTakeNegative(t *testing.T) {
+	items := []int{1, 2, 3}
+	assert.DeepEqual(t, Take(items, -1), []int{})
+	assert.DeepEqual(t, TakeRight(items, -1), []int{})
+
+	_, err := TakeChecked(items, -1)
+	assert.Assert(t, errors.Is(err, ErrInvalidSize))
+
+	_, err = TakeChecked(items, 4)
+	assert.Assert(t, errors.Is(err, ErrOutOfRange))
+
+	results, err := TakeChecked(items, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, results, []int{1, 2})
+}
+
+func TestChunkView(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	views := ChunkView(items, 2)
+	assert.DeepEqual(t, views, [][]int{{1, 2}, {3, 4}, {5}})
+
+	views[0][0] = 9
+	assert.Equal(t, items[0], 9)
+
+	views[0] = append(views[0], 8)
+	assert.Equal(t, items[2], 3)
+
+	chunks := Chunk(items, 2)
+	chunks[0][0] = 1
+	assert.Equal(t, items[0], 9)
+}
+
+func TestAllocations(t *testing.T) {
+	items := make([]int, 1000)
+	slices := ChunkView(items, 10)
+
+	allocs := map[string]func(){
+		"Map":          func() { Map(items, func(i int) int { return i }) },
+		"Filter":       func() { Filter(items, func(i int) bool { return true }) },
+		"Chunk":        func() { Chunk(items, 10) },
+		"ChunkView":    func() { ChunkView(items, 10) },
+		"Concat":       func() { Concat(items, items) },
+		"ConcatSlices": func() { ConcatSlices(slices...) },
+		"Zip":          func() { Zip(items, items) },
+	}
+	expected := map[string]float64{
+		"Map": 1, "Filter": 1, "Chunk": 2, "ChunkView": 1, "Concat": 1, "ConcatSlices": 1, "Zip": 2,
+	}
+
+	for name, fn := range allocs {
+		assert.Equal(t, testing.AllocsPerRun(10, fn), expected[name], name)
+	}
+}
+
+func TestSameValueZeroFloats(t *testing.T) {
+	nan, negZero := math.NaN(), math.Copysign(0, -1)
+	items := []float64{1, nan, negZero, nan, 0, 2}
+
+	index, ok := IndexOf(items, nan)
+	assert.Equal(t, index, 1)
+	assert.Equal(t, ok, true)
+	index, _ = LastIndexOf(items, nan)
+	assert.Equal(t, index, 3)
+	index, _ = IndexOf(items, 0)
+	assert.Equal(t, index, 2)
+
+	uniq := Uniq(items)
+	assert.Equal(t, len(uniq), 4)
+	assert.Assert(t, math.IsNaN(uniq[1]))
+	assert.Equal(t, math.Signbit(uniq[2]), true)
+
+	assert.DeepEqual(t, Difference(items, []float64{nan, 0}), []float64{1, 2})
+	assert.DeepEqual(t, Without(items, nan, 0), []float64{1, 2})
+	assert.DeepEqual(t, WithoutAll(items, []float64{nan}), []float64{1, negZero, 0, 2})
+	assert.Equal(t, len(Intersection(items, []float64{nan})), 2)
+	assert.Equal(t, len(Union([]float64{nan}, []float64{nan, 1})), 2)
+	assert.DeepEqual(t, Xor([]float64{nan, 1}, []float64{nan, 2}), []float64{1, 2})
+
+	pulled := []float64{nan, 1, nan}
+	Pull(&pulled, nan)
+	assert.DeepEqual(t, pulled, []float64{1})
+
+	items32 := []float32{float32(nan), float32(negZero), float32(nan), 0}
+	assert.Equal(t, len(Uniq(items32)), 2)
+	assert.Equal(t, len(UniqInPlace(append([]float32{}, items32...))), 2)
+	assert.Equal(t, len(UniqAppend(nil, items32)), 2)
+	assert.Equal(t, len(WithoutInPlace(append([]float32{}, items32...), float32(nan))), 2)
+	_, ok = IndexOf(items32, float32(nan))
+	assert.Equal(t, ok, true)
+
+	type point struct{ X, Y float64 }
+	points := []point{{nan, 1}, {nan, 2}, {nan, 1}}
+	assert.Equal(t, len(Uniq(points)), 2)
+	assert.Equal(t, len(UniqBy(items, func(f float64) float64 { return f })), 4)
+}
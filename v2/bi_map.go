@@ -0,0 +1,108 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Returned by BiMap.Put when the value is already bound to another key.
+var ErrBiMapConflict = errors.New("godash: value is already bound to another key")
+
+// BiMap is a one-to-one map that preserves the uniqueness of its values as well as its keys,
+// so values can be looked up by key and keys can be looked up by value.
+type BiMap[K comparable, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// Creates an empty bi map.
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+func (m *BiMap[K, V]) init() {
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.inverse = make(map[V]K)
+	}
+}
+
+// Binds key to value. The previous value of key is replaced.
+// Returns ErrBiMapConflict if value is already bound to another key.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if existing, found := m.inverse[value]; found && existing != key {
+		return fmt.Errorf("%w: %v is bound to %v", ErrBiMapConflict, value, existing)
+	}
+
+	m.ForcePut(key, value)
+	return nil
+}
+
+// This method is like Put except that the existing binding of value is removed instead of returning an error.
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.init()
+
+	if existing, found := m.inverse[value]; found {
+		delete(m.forward, existing)
+	}
+
+	if previous, found := m.forward[key]; found {
+		delete(m.inverse, previous)
+	}
+
+	m.forward[key] = value
+	m.inverse[value] = key
+}
+
+// Gets the value of key.
+func (m *BiMap[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = m.forward[key]
+	return
+}
+
+// Gets the key of value.
+func (m *BiMap[K, V]) GetKey(value V) (key K, ok bool) {
+	key, ok = m.inverse[value]
+	return
+}
+
+// Removes key and its value. Returns false if key is not found.
+func (m *BiMap[K, V]) Remove(key K) bool {
+	value, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.inverse, value)
+	}
+
+	return ok
+}
+
+// Removes value and its key. Returns false if value is not found.
+func (m *BiMap[K, V]) RemoveValue(value V) bool {
+	return m.Inverse().Remove(value)
+}
+
+// Gets the count of bindings.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Gets the inverse view of the map, whose keys are the values of this map.
+// The view shares the storage of this map, so changes to either are visible in both.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	m.init()
+	return &BiMap[V, K]{forward: m.inverse, inverse: m.forward}
+}
+
+// Converts the map to a plain map.
+func (m *BiMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, len(m.forward))
+	for key, value := range m.forward {
+		result[key] = value
+	}
+
+	return result
+}
@@ -0,0 +1,79 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestBiMapPut(t *testing.T) {
+	m := NewBiMap[string, int]()
+	assert.NilError(t, m.Put("a", 1))
+	assert.NilError(t, m.Put("b", 2))
+	assert.NilError(t, m.Put("a", 1))
+
+	err := m.Put("c", 1)
+	assert.Assert(t, errors.Is(err, ErrBiMapConflict))
+
+	value, ok := m.Get("a")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 1)
+
+	key, ok := m.GetKey(2)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, key, "b")
+
+	assert.NilError(t, m.Put("a", 3))
+	_, ok = m.GetKey(1)
+	assert.Equal(t, ok, false)
+	assert.DeepEqual(t, m.ToMap(), map[string]int{"a": 3, "b": 2})
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 1)
+
+	_, ok := m.Get("a")
+	assert.Equal(t, ok, false)
+	assert.Equal(t, m.Len(), 1)
+	assert.DeepEqual(t, m.Inverse().ToMap(), map[int]string{1: "b"})
+}
+
+func TestBiMapRemove(t *testing.T) {
+	var m BiMap[string, int]
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+
+	assert.Equal(t, m.Remove("a"), true)
+	assert.Equal(t, m.Remove("a"), false)
+	assert.Equal(t, m.RemoveValue(2), true)
+	assert.Equal(t, m.Len(), 0)
+	assert.Equal(t, m.Inverse().Len(), 0)
+}
+
+func TestBiMapInverse(t *testing.T) {
+	m := NewBiMap[string, int]()
+	inverse := m.Inverse()
+	assert.NilError(t, inverse.Put(1, "a"))
+
+	value, ok := m.Get("a")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 1)
+	assert.Assert(t, errors.Is(m.Put("b", 1), ErrBiMapConflict))
+}
+
+func ExampleBiMap() {
+	codes := NewBiMap[string, int]()
+	codes.Put("OK", 200)
+	codes.Put("NotFound", 404)
+
+	name, _ := codes.GetKey(404)
+	fmt.Println(name)
+	fmt.Println(codes.Put("Found", 200))
+	// Output:
+	// NotFound
+	// godash: value is already bound to another key: 200 is bound to OK
+}
@@ -0,0 +1,276 @@
+package godash
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// EvictionReason describes why an entry left the cache.
+type EvictionReason int
+
+const (
+	// The entry was the least recently used one when the cache was full.
+	EvictedByCapacity EvictionReason = iota
+	// The entry was expired.
+	EvictedByExpiration
+	// The entry was removed by Remove or Clear.
+	EvictedByRemoval
+)
+
+// CacheOptions configures a Cache.
+type CacheOptions[K comparable, V any] struct {
+	// The max count of entries. The cache is unbounded when it is not positive.
+	Capacity int
+	// The default time to live of entries. Entries never expire when it is not positive.
+	TTL time.Duration
+	// The clock used to check expiration. SystemClock is used when it is nil.
+	Clock Clock
+	// Invoked after an entry left the cache. It is invoked without holding the cache lock.
+	OnEvict func(key K, value V, reason EvictionReason)
+}
+
+// CacheStats is the hit/miss statistics of a Cache.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+type evictedEntry[K comparable, V any] struct {
+	entry  *cacheEntry[K, V]
+	reason EvictionReason
+}
+
+// Cache is a concurrency safe in-memory cache with least recently used eviction and optional expiration.
+// The zero value is an unbounded cache without expiration ready to use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	options CacheOptions[K, V]
+	entries map[K]*list.Element
+	order   *list.List
+	stats   CacheStats
+}
+
+// Creates a cache with the options.
+func NewCache[K comparable, V any](options CacheOptions[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{options: options}
+	c.init()
+	return c
+}
+
+func (c *Cache[K, V]) init() {
+	if c.entries == nil {
+		c.entries = make(map[K]*list.Element)
+		c.order = list.New()
+	}
+
+	if c.options.Clock == nil {
+		c.options.Clock = SystemClock
+	}
+}
+
+func (c *Cache[K, V]) expired(entry *cacheEntry[K, V], now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
+
+func (c *Cache[K, V]) remove(element *list.Element, reason EvictionReason, evicted []evictedEntry[K, V]) []evictedEntry[K, V] {
+	entry := element.Value.(*cacheEntry[K, V])
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+
+	if reason != EvictedByRemoval {
+		c.stats.Evictions++
+	}
+
+	return append(evicted, evictedEntry[K, V]{entry, reason})
+}
+
+func (c *Cache[K, V]) notify(evicted []evictedEntry[K, V]) {
+	if c.options.OnEvict == nil {
+		return
+	}
+
+	for _, e := range evicted {
+		c.options.OnEvict(e.entry.key, e.entry.value, e.reason)
+	}
+}
+
+// Gets the value of key and marks it as the most recently used.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	var evicted []evictedEntry[K, V]
+
+	c.mu.Lock()
+	c.init()
+	element, found := c.entries[key]
+	if found {
+		entry := element.Value.(*cacheEntry[K, V])
+		if c.expired(entry, c.options.Clock.Now()) {
+			evicted = c.remove(element, EvictedByExpiration, evicted)
+		} else {
+			c.order.MoveToFront(element)
+			value, ok = entry.value, true
+		}
+	}
+
+	if ok {
+		c.stats.Hits++
+	} else {
+		c.stats.Misses++
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return
+}
+
+// Checks if key is in the cache and not expired. It does not affect the statistics or the usage order.
+func (c *Cache[K, V]) Has(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+
+	element, found := c.entries[key]
+	return found && !c.expired(element.Value.(*cacheEntry[K, V]), c.options.Clock.Now())
+}
+
+// Sets the value of key with the default TTL.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.options.TTL)
+}
+
+// Sets the value of key which expires after ttl. The entry never expires when ttl is not positive.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var evicted []evictedEntry[K, V]
+
+	c.mu.Lock()
+	c.init()
+	entry := &cacheEntry[K, V]{key: key, value: value}
+	if ttl > 0 {
+		entry.expiresAt = c.options.Clock.Now().Add(ttl)
+	}
+
+	if element, found := c.entries[key]; found {
+		element.Value = entry
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(entry)
+	}
+
+	for c.options.Capacity > 0 && c.order.Len() > c.options.Capacity {
+		evicted = c.remove(c.order.Back(), EvictedByCapacity, evicted)
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Removes key from the cache. Returns false if key is not found.
+func (c *Cache[K, V]) Remove(key K) bool {
+	var evicted []evictedEntry[K, V]
+
+	c.mu.Lock()
+	c.init()
+	element, found := c.entries[key]
+	if found {
+		evicted = c.remove(element, EvictedByRemoval, evicted)
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return found
+}
+
+// Removes all expired entries.
+func (c *Cache[K, V]) Purge() {
+	var evicted []evictedEntry[K, V]
+
+	c.mu.Lock()
+	c.init()
+	now := c.options.Clock.Now()
+	for element := c.order.Back(); element != nil; {
+		prev := element.Prev()
+		if c.expired(element.Value.(*cacheEntry[K, V]), now) {
+			evicted = c.remove(element, EvictedByExpiration, evicted)
+		}
+
+		element = prev
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Removes all entries.
+func (c *Cache[K, V]) Clear() {
+	var evicted []evictedEntry[K, V]
+
+	c.mu.Lock()
+	c.init()
+	for element := c.order.Back(); element != nil; element = c.order.Back() {
+		evicted = c.remove(element, EvictedByRemoval, evicted)
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Gets the count of entries after expired entries are purged.
+func (c *Cache[K, V]) Len() int {
+	c.Purge()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+
+	return c.order.Len()
+}
+
+// Gets the keys from the most recently used to the least recently used, expired entries excluded.
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+
+	now := c.options.Clock.Now()
+	result := make([]K, 0, c.order.Len())
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		if entry := element.Value.(*cacheEntry[K, V]); !c.expired(entry, now) {
+			result = append(result, entry.key)
+		}
+	}
+
+	return result
+}
+
+// Gets the hit/miss statistics.
+func (c *Cache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Creates a function that memoizes the result of fn in cache. An unbounded cache is used when cache is nil.
+// Concurrent calls with the same missing key may invoke fn more than once.
+func Memoize[K comparable, V any](fn func(K) V, cache *Cache[K, V]) func(K) V {
+	if cache == nil {
+		cache = NewCache(CacheOptions[K, V]{})
+	}
+
+	return func(key K) V {
+		if value, ok := cache.Get(key); ok {
+			return value
+		}
+
+		value := fn(key)
+		cache.Set(key, value)
+		return value
+	}
+}
@@ -0,0 +1,147 @@
+package godash
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestCacheLRU(t *testing.T) {
+	evicted := []string{}
+	cache := NewCache(CacheOptions[string, int]{
+		Capacity: 2,
+		OnEvict: func(key string, value int, reason EvictionReason) {
+			assert.Equal(t, reason, EvictedByCapacity)
+			evicted = append(evicted, key)
+		},
+	})
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("a")
+	cache.Set("c", 3)
+
+	assert.DeepEqual(t, evicted, []string{"b"})
+	assert.DeepEqual(t, cache.Keys(), []string{"c", "a"})
+
+	_, ok := cache.Get("b")
+	assert.Equal(t, ok, false)
+	assert.Equal(t, cache.Stats(), CacheStats{Hits: 1, Misses: 1, Evictions: 1})
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	reasons := []EvictionReason{}
+	cache := NewCache(CacheOptions[string, int]{
+		TTL:   time.Minute,
+		Clock: clock,
+		OnEvict: func(key string, value int, reason EvictionReason) {
+			reasons = append(reasons, reason)
+		},
+	})
+
+	cache.Set("a", 1)
+	cache.SetWithTTL("b", 2, time.Hour)
+	cache.SetWithTTL("c", 3, 0)
+
+	clock.Advance(59 * time.Second)
+	assert.Equal(t, cache.Has("a"), true)
+
+	clock.Advance(time.Second)
+	_, ok := cache.Get("a")
+	assert.Equal(t, ok, false)
+	assert.Equal(t, cache.Len(), 2)
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, cache.Has("b"), false)
+	assert.DeepEqual(t, cache.Keys(), []string{"c"})
+	assert.Equal(t, cache.Len(), 1)
+	assert.DeepEqual(t, reasons, []EvictionReason{EvictedByExpiration, EvictedByExpiration})
+}
+
+func TestCacheRemove(t *testing.T) {
+	reasons := []EvictionReason{}
+	cache := NewCache(CacheOptions[int, int]{
+		OnEvict: func(key int, value int, reason EvictionReason) {
+			reasons = append(reasons, reason)
+		},
+	})
+
+	cache.Set(1, 1)
+	cache.Set(2, 2)
+	cache.Set(3, 3)
+
+	assert.Equal(t, cache.Remove(1), true)
+	assert.Equal(t, cache.Remove(1), false)
+	cache.Clear()
+
+	assert.Equal(t, cache.Len(), 0)
+	assert.DeepEqual(t, reasons, []EvictionReason{EvictedByRemoval, EvictedByRemoval, EvictedByRemoval})
+	assert.Equal(t, cache.Stats().Evictions, int64(0))
+}
+
+func TestCacheZeroValue(t *testing.T) {
+	var cache Cache[string, int]
+	assert.Equal(t, cache.Has("a"), false)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	value, ok := cache.Get("a")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 1)
+	assert.DeepEqual(t, cache.Keys(), []string{"a", "b"})
+	assert.Equal(t, cache.Len(), 2)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	cache := NewCache(CacheOptions[int, int]{Capacity: 10})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				cache.Set(j%20, n)
+				cache.Get(j % 15)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	assert.Equal(t, cache.Len(), 10)
+	stats := cache.Stats()
+	assert.Equal(t, stats.Hits+stats.Misses, int64(8000))
+}
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	square := Memoize(func(i int) int {
+		calls++
+		return i * i
+	}, nil)
+
+	assert.Equal(t, square(3), 9)
+	assert.Equal(t, square(3), 9)
+	assert.Equal(t, calls, 1)
+}
+
+func ExampleMemoize() {
+	cache := NewCache(CacheOptions[int, string]{Capacity: 100})
+	format := Memoize(func(i int) string {
+		fmt.Println("computing", i)
+		return strconv.Itoa(i)
+	}, cache)
+
+	fmt.Println(format(1), format(1), format(2))
+	fmt.Println(cache.Stats())
+	// Output:
+	// computing 1
+	// computing 2
+	// 1 1 2
+	// {1 2 0}
+}
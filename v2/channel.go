@@ -0,0 +1,216 @@
+package godash
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// All channel functions stop and close their output channels once ctx is done or their inputs are closed.
+// Callers should cancel ctx when they stop reading, otherwise the sending goroutines are blocked forever.
+
+func send[E any](ctx context.Context, out chan<- E, item E) bool {
+	select {
+	case out <- item:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func receive[E any](ctx context.Context, in <-chan E) (item E, ok bool) {
+	select {
+	case item, ok = <-in:
+		return
+	case <-ctx.Done():
+		return
+	}
+}
+
+// Creates a channel emitting the elements of items in order. The channel is closed after the last element.
+func FromSlice[E any](ctx context.Context, items []E) <-chan E {
+	out := make(chan E)
+
+	go func() {
+		defer close(out)
+		for _, item := range items {
+			if !send(ctx, out, item) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Reads all elements from the channel until it is closed.
+// Returns the elements read so far and ctx.Err() if ctx is done before the channel is closed.
+func Collect[E any](ctx context.Context, in <-chan E) ([]E, error) {
+	result := []E{}
+	for {
+		select {
+		case item, ok := <-in:
+			if !ok {
+				return result, nil
+			}
+
+			result = append(result, item)
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// This method is like Map except that it reads from and emits to channels.
+// The iteratee is invoked with one argument: (value).
+func MapChan[E, V any](ctx context.Context, in <-chan E, iteratee Iteratee[E, V]) <-chan V {
+	out := make(chan V)
+
+	go func() {
+		defer close(out)
+		for {
+			item, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, iteratee(item)) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// This method is like Filter except that it reads from and emits to channels.
+// The predicate is invoked with one argument: (value).
+func FilterChan[E any](ctx context.Context, in <-chan E, predicate Predicate[E]) <-chan E {
+	out := make(chan E)
+
+	go func() {
+		defer close(out)
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if predicate(item) && !send(ctx, out, item) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Merges the elements of all channels into one channel. The order between channels is not specified.
+// The output channel is closed after all input channels are closed.
+func Merge[E any](ctx context.Context, ins ...<-chan E) <-chan E {
+	out := make(chan E)
+
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan E) {
+			defer wg.Done()
+			for {
+				item, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, item) {
+					return
+				}
+			}
+		}(in)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Distributes the elements of the channel to the given count of workers running concurrently,
+// and emits their results in the order they complete. At least one worker is started.
+func FanOut[E, V any](ctx context.Context, in <-chan E, workers int, worker func(E) V) <-chan V {
+	if workers < 1 {
+		workers = 1
+	}
+
+	outs := make([]<-chan V, workers)
+	for i := range outs {
+		outs[i] = MapChan(ctx, in, worker)
+	}
+
+	return Merge(ctx, outs...)
+}
+
+// This method is like Chunk except that it reads from a channel and emits groups to a channel.
+// A group is emitted once it has size elements, or maxWait has elapsed since its first element was read.
+// The remaining elements are emitted after the input channel is closed. A group is never empty.
+// Groups are emitted only by size when maxWait is not positive. No group is emitted when size is not positive.
+func ChunkChan[E any](ctx context.Context, in <-chan E, size int, maxWait time.Duration) <-chan []E {
+	return ChunkChanWithClock(ctx, in, size, maxWait, SystemClock)
+}
+
+// This method is like ChunkChan except that maxWait is measured by the given clock.
+func ChunkChanWithClock[E any](ctx context.Context, in <-chan E, size int, maxWait time.Duration, clock Clock) <-chan []E {
+	out := make(chan []E)
+
+	go func() {
+		defer close(out)
+		if size <= 0 {
+			return
+		}
+
+		var chunk []E
+		var timer Timer
+		var timeout <-chan time.Time
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(chunk) == 0 {
+				return true
+			}
+
+			items := chunk
+			chunk = nil
+			return send(ctx, out, items)
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				chunk = append(chunk, item)
+				if len(chunk) == 1 && maxWait > 0 {
+					timer = clock.NewTimer(maxWait)
+					timeout = timer.C()
+				}
+
+				if len(chunk) == size && !flush() {
+					return
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,132 @@
+package godash
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestFromSliceCollect(t *testing.T) {
+	ctx := context.Background()
+	result, err := Collect(ctx, FromSlice(ctx, []int{1, 2, 3}))
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, []int{1, 2, 3})
+}
+
+func TestCollectCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	cancel()
+
+	result, err := Collect(ctx, in)
+	assert.Equal(t, err, context.Canceled)
+	assert.DeepEqual(t, result, []int{})
+}
+
+func TestFromSliceCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := FromSlice(ctx, []int{1, 2, 3})
+
+	assert.Equal(t, <-out, 1)
+	cancel()
+
+	for range out {
+	}
+}
+
+func TestMapFilterChan(t *testing.T) {
+	ctx := context.Background()
+	in := FromSlice(ctx, []int{1, 2, 3, 4})
+	even := FilterChan(ctx, in, func(i int) bool { return i%2 == 0 })
+	squared := MapChan(ctx, even, func(i int) int { return i * i })
+
+	result, err := Collect(ctx, squared)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, []int{4, 16})
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+	merged := Merge(ctx, FromSlice(ctx, []int{1, 2}), FromSlice(ctx, []int{3}), FromSlice(ctx, []int{}))
+
+	result, err := Collect(ctx, merged)
+	assert.NilError(t, err)
+	sort.Ints(result)
+	assert.DeepEqual(t, result, []int{1, 2, 3})
+
+	result, _ = Collect(ctx, Merge[int](ctx))
+	assert.DeepEqual(t, result, []int{})
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+	in := FromSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	out := FanOut(ctx, in, 3, func(i int) int {
+		return i * 10
+	})
+
+	result, err := Collect(ctx, out)
+	assert.NilError(t, err)
+	sort.Ints(result)
+	assert.DeepEqual(t, result, []int{10, 20, 30, 40, 50, 60})
+}
+
+func TestChunkChanBySize(t *testing.T) {
+	ctx := context.Background()
+	chunks := ChunkChan(ctx, FromSlice(ctx, []int{1, 2, 3, 4, 5}), 2, time.Hour)
+
+	result, err := Collect(ctx, chunks)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, [][]int{{1, 2}, {3, 4}, {5}})
+}
+
+func TestChunkChanByTime(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Unix(0, 0))
+	in := make(chan int)
+	chunks := ChunkChanWithClock(ctx, in, 10, time.Second, clock)
+
+	in <- 1
+	in <- 2
+	clock.Advance(time.Second - 1)
+	in <- 3
+	clock.Advance(1)
+	assert.DeepEqual(t, <-chunks, []int{1, 2, 3})
+
+	in <- 4
+	close(in)
+	assert.DeepEqual(t, <-chunks, []int{4})
+
+	_, ok := <-chunks
+	assert.Equal(t, ok, false)
+}
+
+func TestChunkChanCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	chunks := ChunkChan(ctx, in, 10, 0)
+
+	in <- 1
+	cancel()
+
+	_, ok := <-chunks
+	assert.Equal(t, ok, false)
+}
+
+func ExampleChunkChan() {
+	ctx := context.Background()
+	in := FromSlice(ctx, []string{"a", "b", "c", "d", "e"})
+
+	for chunk := range ChunkChan(ctx, in, 2, time.Second) {
+		fmt.Println(chunk)
+	}
+	// Output:
+	// [a b]
+	// [c d]
+	// [e]
+}
@@ -0,0 +1,140 @@
+package godash
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock abstracts the passage of time so that time based helpers can be tested without waiting.
+type Clock interface {
+	// Returns the current time.
+	Now() time.Time
+	// Waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+	// Creates a Timer that sends the current time on its channel once the duration has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event created by a Clock, like time.Timer.
+type Timer interface {
+	// Returns the channel on which the time is sent when the timer fires.
+	C() <-chan time.Time
+	// Prevents the timer from firing. Returns false if the timer has already fired or been stopped.
+	Stop() bool
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// The Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+// FakeClock is a manually driven Clock. Its time only moves by calling Advance, or by waiting on After
+// which advances the clock by the requested duration immediately, so retries and TTLs complete instantly in tests.
+// Timers created by NewTimer fire when the clock is advanced to or past their deadlines.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// Creates a FakeClock starting at the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Returns the current fake time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advances the clock by d and returns a channel that already holds the new time.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.Advance(d)
+	return ch
+}
+
+// Creates a Timer that fires once the clock is advanced by d. It fires immediately when d is not positive.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &fakeTimer{clock: c, deadline: c.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.ch <- c.now
+	} else {
+		c.timers = append(c.timers, timer)
+	}
+
+	return timer
+}
+
+// Moves the clock forward by d, fires the timers whose deadlines have been reached and returns the new time.
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+
+	c.timers = Filter(c.timers, func(timer *fakeTimer) bool {
+		if timer.deadline.After(c.now) {
+			return true
+		}
+
+		timer.ch <- c.now
+		return false
+	})
+
+	return c.now
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	pending := len(t.clock.timers)
+	t.clock.timers = Filter(t.clock.timers, func(timer *fakeTimer) bool {
+		return timer != t
+	})
+
+	return len(t.clock.timers) < pending
+}
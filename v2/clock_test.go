@@ -0,0 +1,28 @@
+package godash
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestFakeClockTimer(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	timer := clock.NewTimer(time.Second)
+	stopped := clock.NewTimer(time.Second)
+
+	assert.Equal(t, stopped.Stop(), true)
+	clock.Advance(time.Second - 1)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	clock.Advance(1)
+	assert.Equal(t, <-timer.C(), time.Unix(1, 0))
+	assert.Equal(t, timer.Stop(), false)
+	assert.Equal(t, stopped.Stop(), false)
+	assert.Equal(t, len(stopped.C()), 0)
+}
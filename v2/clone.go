@@ -0,0 +1,240 @@
+package godash
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Cloner is implemented by types that know how to clone themselves. CloneDeep uses the Clone method
+// instead of copying the fields of such types. Clone must not call CloneDeep on its own receiver.
+// The value passed to CloneDeep is checked against Cloner[T] directly, so T may also be an interface type.
+// Nested values are found by reflection: a value of type X is cloned by its method Clone() X,
+// i.e. when X implements Cloner[X].
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// CloneOptions configures CloneDeepWithOptions.
+type CloneOptions struct {
+	// Deep clones unexported struct fields as well. Otherwise they are copied shallowly.
+	Unexported bool
+	// Invoked with each value before it is cloned. When it returns true, its result is used as the clone.
+	Customizer func(value any) (any, bool)
+}
+
+// Creates a shallow clone of value. Slices, maps and pointees are copied, their elements are not.
+func Clone[T any](value T) T {
+	src := reflect.ValueOf(&value).Elem()
+
+	var dst reflect.Value
+	switch src.Kind() {
+	case reflect.Slice:
+		if src.IsNil() {
+			return value
+		}
+
+		dst = reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		reflect.Copy(dst, src)
+	case reflect.Map:
+		if src.IsNil() {
+			return value
+		}
+
+		dst = reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), iter.Value())
+		}
+	case reflect.Pointer:
+		if src.IsNil() {
+			return value
+		}
+
+		dst = reflect.New(src.Type().Elem())
+		dst.Elem().Set(src.Elem())
+	default:
+		return value
+	}
+
+	return dst.Interface().(T)
+}
+
+// Creates a deep clone of value. Maps, slices, arrays, pointers, interfaces and exported struct fields are
+// cloned recursively, cyclic references are preserved. Channels, functions and unexported struct fields are copied.
+// Types implementing Cloner are cloned by their Clone method.
+func CloneDeep[T any](value T) T {
+	return CloneDeepWithOptions(value, CloneOptions{})
+}
+
+// This method is like CloneDeep except that it accepts customizer which is invoked to produce the cloned value.
+// If customizer returns false, cloning is handled by the method instead.
+func CloneWith[T any](value T, customizer func(value any) (any, bool)) T {
+	return CloneDeepWithOptions(value, CloneOptions{Customizer: customizer})
+}
+
+// This method is like CloneDeep except that it accepts options.
+func CloneDeepWithOptions[T any](value T, options CloneOptions) T {
+	if cloner, ok := any(value).(Cloner[T]); ok && options.Customizer == nil && !IsNil(value) {
+		return cloner.Clone()
+	}
+
+	c := cloner{options: options, visited: map[cloneVisit]reflect.Value{}}
+	src := reflect.ValueOf(&value).Elem()
+
+	dst := reflect.New(src.Type()).Elem()
+	c.clone(dst, src)
+	return dst.Interface().(T)
+}
+
+type cloneVisit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+type cloner struct {
+	options CloneOptions
+	visited map[cloneVisit]reflect.Value
+}
+
+const clonerMethodName = "Clone"
+
+func (c *cloner) custom(dst reflect.Value, src reflect.Value) bool {
+	if !src.CanInterface() {
+		return false
+	}
+
+	if c.options.Customizer != nil {
+		if result, ok := c.options.Customizer(src.Interface()); ok {
+			if result == nil {
+				dst.SetZero()
+			} else {
+				dst.Set(reflect.ValueOf(result))
+			}
+
+			return true
+		}
+	}
+
+	if src.Kind() == reflect.Pointer && src.IsNil() || src.Kind() == reflect.Interface {
+		return false
+	}
+
+	method := src.MethodByName(clonerMethodName)
+	if method.IsValid() && method.Type().NumIn() == 0 && method.Type().NumOut() == 1 &&
+		method.Type().Out(0) == src.Type() {
+		dst.Set(method.Call(nil)[0])
+		return true
+	}
+
+	return false
+}
+
+// Clones src into dst, which must be settable and of the same type.
+func (c *cloner) clone(dst reflect.Value, src reflect.Value) {
+	if c.custom(dst, src) {
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+
+		visit := cloneVisit{src.Pointer(), src.Type(), 0}
+		if cloned, found := c.visited[visit]; found {
+			dst.Set(cloned)
+			return
+		}
+
+		cloned := reflect.New(src.Type().Elem())
+		c.visited[visit] = cloned
+		c.clone(cloned.Elem(), src.Elem())
+		dst.Set(cloned)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+
+		elem := src.Elem()
+		cloned := reflect.New(elem.Type()).Elem()
+		c.clone(cloned, elem)
+		dst.Set(cloned)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+
+		visit := cloneVisit{src.Pointer(), src.Type(), src.Len()}
+		if cloned, found := c.visited[visit]; found {
+			dst.Set(cloned)
+			return
+		}
+
+		cloned := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		c.visited[visit] = cloned
+		for i := 0; i < src.Len(); i++ {
+			c.clone(cloned.Index(i), src.Index(i))
+		}
+
+		dst.Set(cloned)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+
+		visit := cloneVisit{src.Pointer(), src.Type(), 0}
+		if cloned, found := c.visited[visit]; found {
+			dst.Set(cloned)
+			return
+		}
+
+		cloned := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.visited[visit] = cloned
+		iter := src.MapRange()
+		for iter.Next() {
+			key := reflect.New(src.Type().Key()).Elem()
+			c.clone(key, iter.Key())
+			value := reflect.New(src.Type().Elem()).Elem()
+			c.clone(value, iter.Value())
+			cloned.SetMapIndex(key, value)
+		}
+
+		dst.Set(cloned)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			c.clone(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		c.cloneStruct(dst, src)
+	default:
+		dst.Set(src)
+	}
+}
+
+func (c *cloner) cloneStruct(dst reflect.Value, src reflect.Value) {
+	if !src.CanAddr() {
+		addressable := reflect.New(src.Type()).Elem()
+		addressable.Set(src)
+		src = addressable
+	}
+
+	dst.Set(src)
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		srcField, dstField := src.Field(i), dst.Field(i)
+
+		if !field.IsExported() {
+			if !c.options.Unexported {
+				continue
+			}
+
+			srcField = reflect.NewAt(field.Type, unsafe.Pointer(srcField.UnsafeAddr())).Elem()
+			dstField = reflect.NewAt(field.Type, unsafe.Pointer(dstField.UnsafeAddr())).Elem()
+		}
+
+		dstField.SetZero()
+		c.clone(dstField, srcField)
+	}
+}
@@ -0,0 +1,158 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+type cloneNode struct {
+	Name     string
+	Children []*cloneNode
+	Parent   *cloneNode
+	Tags     map[string][]string
+	secret   []int
+}
+
+type cloneMoney struct {
+	Amount   int
+	Currency *string
+}
+
+var cloneMoneyCalls = 0
+
+func (m cloneMoney) Clone() cloneMoney {
+	cloneMoneyCalls++
+	currency := *m.Currency
+	return cloneMoney{m.Amount, &currency}
+}
+
+func TestClone(t *testing.T) {
+	items := [][]int{{1}, {2}}
+	cloned := Clone(items)
+	cloned[0] = []int{9}
+	cloned[1][0] = 8
+
+	assert.DeepEqual(t, items, [][]int{{1}, {8}})
+
+	m := map[string]int{"a": 1}
+	clonedMap := Clone(m)
+	clonedMap["a"] = 2
+	assert.Equal(t, m["a"], 1)
+
+	value := 1
+	pointer := Clone(&value)
+	*pointer = 2
+	assert.Equal(t, value, 1)
+
+	var nilSlice []int
+	assert.Assert(t, Clone(nilSlice) == nil)
+}
+
+func TestCloneDeep(t *testing.T) {
+	src := map[string]any{
+		"list":  []any{1, "a", map[string]int{"x": 1}},
+		"array": [2][]int{{1}, {2}},
+		"time":  time.Unix(0, 0),
+	}
+
+	cloned := CloneDeep(src)
+	assert.DeepEqual(t, cloned, src)
+
+	cloned["list"].([]any)[2].(map[string]int)["x"] = 2
+	cloned["array"].([2][]int)[0][0] = 9
+	assert.Equal(t, src["list"].([]any)[2].(map[string]int)["x"], 1)
+	assert.Equal(t, src["array"].([2][]int)[0][0], 1)
+}
+
+func TestCloneDeepStruct(t *testing.T) {
+	root := &cloneNode{Name: "root", Tags: map[string][]string{"a": {"b"}}, secret: []int{1}}
+	child := &cloneNode{Name: "child", Parent: root}
+	root.Children = []*cloneNode{child}
+
+	cloned := CloneDeep(root)
+	assert.Assert(t, cloned != root)
+	assert.Assert(t, cloned.Children[0] != child)
+	assert.Assert(t, cloned.Children[0].Parent == cloned)
+
+	cloned.Tags["a"][0] = "c"
+	assert.Equal(t, root.Tags["a"][0], "b")
+
+	cloned.secret[0] = 2
+	assert.Equal(t, root.secret[0], 2)
+}
+
+func TestCloneDeepUnexported(t *testing.T) {
+	root := &cloneNode{secret: []int{1}}
+	cloned := CloneDeepWithOptions(root, CloneOptions{Unexported: true})
+
+	cloned.secret[0] = 2
+	assert.Equal(t, root.secret[0], 1)
+}
+
+func TestCloneDeepCloner(t *testing.T) {
+	currency := "USD"
+	prices := []cloneMoney{{1, &currency}, {2, &currency}}
+	cloneMoneyCalls = 0
+
+	cloned := CloneDeep(prices)
+	*cloned[0].Currency = "EUR"
+
+	assert.Equal(t, currency, "USD")
+	assert.Equal(t, cloneMoneyCalls, 2)
+}
+
+type cloneShape interface {
+	Clone() cloneShape
+}
+
+type cloneCircle struct {
+	Radius *int
+}
+
+var cloneCircleCalls = 0
+
+func (c *cloneCircle) Clone() cloneShape {
+	cloneCircleCalls++
+	radius := *c.Radius
+	return &cloneCircle{&radius}
+}
+
+func TestCloneDeepClonerInterface(t *testing.T) {
+	radius := 1
+	var shape cloneShape = &cloneCircle{&radius}
+	cloneCircleCalls = 0
+
+	cloned := CloneDeep(shape)
+	*cloned.(*cloneCircle).Radius = 2
+
+	assert.Equal(t, radius, 1)
+	assert.Equal(t, cloneCircleCalls, 1)
+}
+
+func TestCloneWith(t *testing.T) {
+	src := map[string][]int{"a": {1, 2}, "b": {3}}
+	cloned := CloneWith(src, func(value any) (any, bool) {
+		if items, ok := value.([]int); ok {
+			return Reverse(append([]int{}, items...)), true
+		}
+
+		return nil, false
+	})
+
+	assert.DeepEqual(t, cloned, map[string][]int{"a": {2, 1}, "b": {3}})
+	assert.DeepEqual(t, src, map[string][]int{"a": {1, 2}, "b": {3}})
+}
+
+func ExampleCloneDeep() {
+	src := map[string][]string{"fruits": {"apple"}}
+	cloned := CloneDeep(src)
+	cloned["fruits"] = append(cloned["fruits"], "banana")
+	cloned["fruits"][0] = "pear"
+
+	fmt.Println(src, cloned)
+	// Output:
+	// map[fruits:[apple]] map[fruits:[pear banana]]
+}
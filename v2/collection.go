@@ -0,0 +1,57 @@
+package godash
+
+// Creates an object composed of keys generated from the results of running each element of collection thru iteratee.
+// The order of grouped values is determined by the order they occur in collection.
+// The corresponding value of each key is an array of elements responsible for generating the key.
+// Keys implementing Equaler are grouped by their Equal method, using the first key generated for each group.
+// The iteratee is invoked with one argument: (value).
+func GroupBy[E any, K comparable](items []E, iteratee Iteratee[E, K]) MultiMap[K, E] {
+	result := MultiMap[K, E]{}
+	keys := newHashSet[K]()
+
+	for _, item := range items {
+		key := iteratee(item)
+		if _, custom := any(key).(Equaler[K]); custom {
+			keys.add(key)
+			key, _ = keys.get(key)
+		}
+
+		result.Put(key, item)
+	}
+
+	return result
+}
+
+// Creates an object composed of keys generated from the results of running each element of collection thru iteratee.
+// The corresponding value of each key is the last element responsible for generating the key.
+// The iteratee is invoked with one argument: (value).
+func KeyBy[E any, K comparable](items []E, iteratee Iteratee[E, K]) map[K]E {
+	result := make(map[K]E, len(items))
+
+	for _, item := range items {
+		result[iteratee(item)] = item
+	}
+
+	return result
+}
+
+// Iterates over elements of collection, returning the first element predicate returns truthy for.
+// The predicate is invoked with one argument: (value).
+func Find[E any](items []E, predicate Predicate[E]) (item E, ok bool) {
+	if index, found := FindIndex(items, predicate); found {
+		item, ok = items[index], true
+	}
+
+	return
+}
+
+// This method is like Find except that it iterates over elements of collection from right to left.
+func FindLast[E any](items []E, predicate Predicate[E]) (item E, ok bool) {
+	for i := len(items) - 1; i >= 0; i-- {
+		if predicate(items[i]) {
+			return items[i], true
+		}
+	}
+
+	return
+}
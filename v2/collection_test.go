@@ -0,0 +1,58 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	items := []float64{6.1, 4.2, 6.3}
+	result := GroupBy(items, math.Floor)
+
+	assert.Equal(t, len(result), 2)
+	assert.DeepEqual(t, result.GetAll(6), []float64{6.1, 6.3})
+	assert.DeepEqual(t, result[4], []float64{4.2})
+
+	_, found := result[5]
+	assert.Equal(t, found, false)
+}
+
+func ExampleGroupBy() {
+	result := GroupBy([]string{"one", "two", "three"}, func(s string) int {
+		return len(s)
+	})
+	fmt.Println(result.Keys(), result.GetAll(3))
+	// Output:
+	// [3 5] [one two]
+}
+
+func TestKeyBy(t *testing.T) {
+	items := []string{"apple", "avocado", "banana"}
+	result := KeyBy(items, func(s string) byte {
+		return s[0]
+	})
+
+	assert.DeepEqual(t, result, map[byte]string{'a': "avocado", 'b': "banana"})
+}
+
+func TestFind(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	item, ok := Find(items, isEven)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, item, 2)
+
+	item, ok = FindLast(items, isEven)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, item, 4)
+
+	_, ok = Find(items, func(i int) bool { return i > 4 })
+	assert.Equal(t, ok, false)
+
+	_, ok = FindLast(items, func(i int) bool { return i > 4 })
+	assert.Equal(t, ok, false)
+}
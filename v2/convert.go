@@ -0,0 +1,426 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// Converts value to a string. Nil values, including nil pointers, are converted to "", -0 to "-0", and numbers are
+// formatted like JavaScript numbers, e.g. 1e21 to "1e+21". Values implementing error or fmt.Stringer are converted
+// by their methods.
+// Slices and arrays are converted recursively and joined by ",". Other values return ErrInvalidConversion.
+func ToString(value any) (string, error) {
+	if IsNil(value) {
+		return "", nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case error:
+		return v.Error(), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return formatNumber(v.Float(), 32), nil
+	case reflect.Float64:
+		return formatNumber(v.Float(), 64), nil
+	case reflect.Slice, reflect.Array:
+		items := make([]string, v.Len())
+		for i := range items {
+			item, err := ToString(v.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+
+			items[i] = item
+		}
+
+		return strings.Join(items, ","), nil
+	}
+
+	return "", conversionError(value, "string")
+}
+
+// Formats f like JavaScript's Number.prototype.toString, except that -0 is formatted as "-0".
+func formatNumber(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0 && math.Signbit(f):
+		return "-0"
+	}
+
+	if abs := math.Abs(f); abs == 0 || abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, bitSize)
+	}
+
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, bitSize), "e")
+	sign, digits := exponent[:1], strings.TrimLeft(exponent[1:], "0")
+	return mantissa + "e" + sign + digits
+}
+
+// Converts value to a number. Nil and false are converted to 0, true to 1. Strings are trimmed and converted as
+// decimal, "0x" hexadecimal, "0o" octal or "0b" binary literals, where an empty string is 0 and "Infinity" is +Inf.
+// Strings that are not numeric literals, such as "12px", and other values return ErrInvalidConversion.
+func ToNumber(value any) (float64, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, nil
+		}
+
+		return 0, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		if number, ok := parseNumber(v.String()); ok {
+			return number, nil
+		}
+	}
+
+	return 0, conversionError(value, "number")
+}
+
+func parseNumber(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, true
+	}
+
+	if len(s) > 2 && s[0] == '0' {
+		base := 0
+		switch s[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+
+		if base != 0 {
+			number, err := strconv.ParseUint(s[2:], base, 64)
+			return float64(number), err == nil || errors.Is(err, strconv.ErrRange)
+		}
+	}
+
+	switch s {
+	case "Infinity", "+Infinity":
+		return math.Inf(1), true
+	case "-Infinity":
+		return math.Inf(-1), true
+	}
+
+	if !decimalPattern.MatchString(s) {
+		return 0, false
+	}
+
+	// Out of range literals are converted to ±Inf like JavaScript numbers.
+	number, _ := strconv.ParseFloat(s, 64)
+	return number, true
+}
+
+// Converts value to an integer. Numbers are truncated towards zero, NaN is converted to 0, and numbers out of
+// the int range are clamped to it. Other values are converted by ToNumber first.
+func ToInt(value any) (int, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt {
+			return math.MaxInt, nil
+		}
+
+		return int(v.Uint()), nil
+	case reflect.String:
+		if number, err := strconv.ParseInt(strings.TrimSpace(v.String()), 10, 0); err == nil {
+			return int(number), nil
+		}
+	}
+
+	number, err := ToNumber(value)
+	if err != nil {
+		return 0, conversionError(value, "int")
+	}
+
+	switch {
+	case math.IsNaN(number):
+		return 0, nil
+	case number >= math.MaxInt:
+		return math.MaxInt, nil
+	case number <= math.MinInt:
+		return math.MinInt, nil
+	}
+
+	return int(number), nil
+}
+
+// Converts value to a boolean. Nil, false, 0, NaN and "" are converted to false, other booleans and numbers to true.
+// Unlike lodash, where any non-empty string is truthy, other strings are parsed by strconv.ParseBool, so "false" and
+// "0" are converted to false. Strings that are not booleans, such as "yes", and other values return
+// ErrInvalidConversion.
+func ToBool(value any) (bool, error) {
+	if value == nil {
+		return false, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Kind() == reflect.Bool || IsNumber(value):
+		return !isFalsy(value), nil
+	case v.Kind() == reflect.String:
+		s := strings.TrimSpace(v.String())
+		if s == "" {
+			return false, nil
+		} else if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+
+	return false, conversionError(value, "bool")
+}
+
+// Converts value to a slice. Nil is converted to an empty slice, slices and arrays to their elements, maps to their
+// values ordered by key, and strings to their characters. Other values return ErrInvalidConversion.
+func ToSlice(value any) ([]any, error) {
+	if value == nil {
+		return []any{}, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		result := make([]any, v.Len())
+		for i := range result {
+			result[i] = v.Index(i).Interface()
+		}
+
+		return result, nil
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return lessValue(keys[i], keys[j])
+		})
+
+		return Map(keys, func(key reflect.Value) any {
+			return v.MapIndex(key).Interface()
+		}), nil
+	case reflect.String:
+		return Map([]rune(v.String()), func(r rune) any {
+			return string(r)
+		}), nil
+	}
+
+	return nil, conversionError(value, "slice")
+}
+
+// Converts each element of items to T. Elements that are already T are kept, other elements are converted by
+// ToString, ToNumber, ToInt, ToBool or ToSlice according to the kind of T. Nil elements are converted to nil when T
+// is an interface, pointer, map, slice, channel or function. Unlike ToInt, numbers out of the range of an integer T
+// are not clamped and can't be converted. Elements that can't be converted are left as zero values, and their errors
+// are joined in the returned error, each prefixed with the element index.
+func ToSliceOf[T any](items []any) ([]T, error) {
+	result := make([]T, len(items))
+	errs := []error{}
+
+	for i, item := range items {
+		converted, err := convertTo[T](item)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+
+		result[i] = converted
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func convertTo[T any](value any) (T, error) {
+	var zero T
+	if converted, ok := value.(T); ok {
+		return converted, nil
+	}
+
+	target := reflect.TypeOf(&zero).Elem()
+	switch target.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		if value == nil {
+			return zero, nil
+		}
+	}
+
+	result := reflect.New(target).Elem()
+	var err error
+
+	switch target.Kind() {
+	case reflect.String:
+		var s string
+		s, err = ToString(value)
+		result.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, err = ToBool(value)
+		result.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if !setInteger(result, value) {
+			err = ErrInvalidConversion
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = ToNumber(value); err == nil && result.OverflowFloat(f) {
+			err = ErrInvalidConversion
+		}
+
+		result.SetFloat(f)
+	case reflect.Slice:
+		var s []any
+		if target.Elem().Kind() != reflect.Interface || target.Elem().NumMethod() != 0 {
+			err = ErrInvalidConversion
+		} else if s, err = ToSlice(value); err == nil {
+			result.Set(reflect.ValueOf(s).Convert(target))
+		}
+	default:
+		err = ErrInvalidConversion
+	}
+
+	if err != nil {
+		return zero, conversionError(value, target.String())
+	}
+
+	return result.Interface().(T), nil
+}
+
+// Sets result, which is of an integer kind, to value converted like ToInt. Unlike ToInt, numbers are not clamped,
+// and false is returned when value can't be converted or is out of the range of result.
+func setInteger(result reflect.Value, value any) bool {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.String {
+		s := strings.TrimSpace(v.String())
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return setInt64(result, n)
+		} else if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return setUint64(result, n)
+		}
+	}
+
+	switch {
+	case v.CanInt():
+		return setInt64(result, v.Int())
+	case v.CanUint():
+		return setUint64(result, v.Uint())
+	}
+
+	f, err := ToNumber(value)
+	if err != nil {
+		return false
+	}
+
+	switch f = math.Trunc(f); {
+	case math.IsNaN(f):
+		return true
+	case f < 0:
+		return f >= math.MinInt64 && setInt64(result, int64(f))
+	default:
+		return f < math.MaxUint64 && setUint64(result, uint64(f))
+	}
+}
+
+func setInt64(result reflect.Value, n int64) bool {
+	if result.CanInt() {
+		if result.OverflowInt(n) {
+			return false
+		}
+
+		result.SetInt(n)
+		return true
+	}
+
+	return n >= 0 && setUint64(result, uint64(n))
+}
+
+func setUint64(result reflect.Value, n uint64) bool {
+	if result.CanUint() {
+		if result.OverflowUint(n) {
+			return false
+		}
+
+		result.SetUint(n)
+		return true
+	}
+
+	return n <= math.MaxInt64 && setInt64(result, int64(n))
+}
+
+// This method is like ToString except that it panics if value can't be converted.
+func MustToString(value any) string {
+	return must(ToString(value))
+}
+
+// This method is like ToNumber except that it panics if value can't be converted.
+func MustToNumber(value any) float64 {
+	return must(ToNumber(value))
+}
+
+// This method is like ToInt except that it panics if value can't be converted.
+func MustToInt(value any) int {
+	return must(ToInt(value))
+}
+
+// This method is like ToBool except that it panics if value can't be converted.
+func MustToBool(value any) bool {
+	return must(ToBool(value))
+}
+
+// This method is like ToSlice except that it panics if value can't be converted.
+func MustToSlice(value any) []any {
+	return must(ToSlice(value))
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
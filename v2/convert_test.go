@@ -0,0 +1,227 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestToString(t *testing.T) {
+	cases := []struct {
+		value    any
+		expected string
+	}{
+		{nil, ""},
+		{"a", "a"},
+		{true, "true"},
+		{-12, "-12"},
+		{uint8(7), "7"},
+		{1.5, "1.5"},
+		{float32(0.1), "0.1"},
+		{math.Copysign(0, -1), "-0"},
+		{1e21, "1e+21"},
+		{1e-7, "1e-7"},
+		{123456789.0, "123456789"},
+		{math.NaN(), "NaN"},
+		{math.Inf(-1), "-Infinity"},
+		{[]any{1, nil, []int{2, 3}}, "1,,2,3"},
+		{time.Second, "1s"},
+		{errors.New("e"), "e"},
+		{(*url.URL)(nil), ""},
+		{(*langError)(nil), ""},
+		{[]int(nil), ""},
+	}
+
+	for _, c := range cases {
+		s, err := ToString(c.value)
+		assert.NilError(t, err)
+		assert.Equal(t, s, c.expected)
+	}
+
+	_, err := ToString(map[string]int{})
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+	_, err = ToString([]any{struct{}{}})
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+}
+
+func TestToNumber(t *testing.T) {
+	cases := []struct {
+		value    any
+		expected float64
+	}{
+		{nil, 0},
+		{true, 1},
+		{false, 0},
+		{int8(-3), -3},
+		{uint(3), 3},
+		{" 12.5 ", 12.5},
+		{"", 0},
+		{"-.5", -0.5},
+		{"1e3", 1000},
+		{"0x1F", 31},
+		{"0o17", 15},
+		{"0b101", 5},
+		{"Infinity", math.Inf(1)},
+		{"1e400", math.Inf(1)},
+	}
+
+	for _, c := range cases {
+		number, err := ToNumber(c.value)
+		assert.NilError(t, err)
+		assert.Equal(t, number, c.expected)
+	}
+
+	for _, value := range []any{"12px", "1_000", "0x1p2", "-0x1", "NaN", "inf", []int{}, struct{}{}} {
+		_, err := ToNumber(value)
+		assert.Assert(t, errors.Is(err, ErrInvalidConversion), "value: %#v", value)
+	}
+}
+
+func TestToInt(t *testing.T) {
+	cases := []struct {
+		value    any
+		expected int
+	}{
+		{nil, 0},
+		{true, 1},
+		{-3.9, -3},
+		{"42", 42},
+		{"9007199254740993", 9007199254740993},
+		{"3.7", 3},
+		{"0x10", 16},
+		{math.NaN(), 0},
+		{math.Inf(1), math.MaxInt},
+		{math.Inf(-1), math.MinInt},
+		{uint64(math.MaxUint64), math.MaxInt},
+	}
+
+	for _, c := range cases {
+		n, err := ToInt(c.value)
+		assert.NilError(t, err)
+		assert.Equal(t, n, c.expected)
+	}
+
+	_, err := ToInt("12px")
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+}
+
+func TestToBool(t *testing.T) {
+	cases := []struct {
+		value    any
+		expected bool
+	}{
+		{nil, false},
+		{true, true},
+		{0, false},
+		{-1, true},
+		{math.NaN(), false},
+		{"", false},
+		{"true", true},
+		{"F", false},
+		{"1", true},
+		// Unlike lodash, non-empty strings are parsed instead of being truthy.
+		{"false", false},
+		{"0", false},
+	}
+
+	for _, c := range cases {
+		b, err := ToBool(c.value)
+		assert.NilError(t, err)
+		assert.Equal(t, b, c.expected)
+	}
+
+	_, err := ToBool("yes")
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+	_, err = ToBool([]int{1})
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+}
+
+func TestToSlice(t *testing.T) {
+	s, err := ToSlice(nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, s, []any{})
+
+	s, _ = ToSlice([2]int{1, 2})
+	assert.DeepEqual(t, s, []any{1, 2})
+	s, _ = ToSlice(map[string]int{"b": 2, "a": 1})
+	assert.DeepEqual(t, s, []any{1, 2})
+	s, _ = ToSlice("héllo")
+	assert.DeepEqual(t, s, []any{"h", "é", "l", "l", "o"})
+
+	_, err = ToSlice(1)
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+}
+
+func TestToSliceOf(t *testing.T) {
+	numbers, err := ToSliceOf[float64]([]any{1, "2.5", true, nil})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, numbers, []float64{1, 2.5, 1, 0})
+
+	strs, err := ToSliceOf[string]([]any{"a", 1, false})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, strs, []string{"a", "1", "false"})
+
+	bytes, err := ToSliceOf[uint8]([]any{1, "300", -1, "12px", uint64(2)})
+	assert.DeepEqual(t, bytes, []uint8{1, 0, 0, 0, 2})
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+	assert.Equal(t, len(err.(interface{ Unwrap() []error }).Unwrap()), 3)
+	assert.ErrorContains(t, err, "index 1:")
+	assert.ErrorContains(t, err, "index 3:")
+
+	slices, err := ToSliceOf[[]any]([]any{[]int{1}, nil})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, slices, [][]any{{1}, nil})
+
+	values, err := ToSliceOf[any]([]any{nil, 1})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, values, []any{nil, 1})
+
+	pointers, err := ToSliceOf[*int]([]any{nil})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pointers, []*int{nil})
+
+	large, err := ToSliceOf[int64]([]any{1e30, "-1e30", math.Inf(1), "9223372036854775807", -3.9, math.NaN()})
+	assert.DeepEqual(t, large, []int64{0, 0, 0, math.MaxInt64, -3, 0})
+	assert.Equal(t, len(err.(interface{ Unwrap() []error }).Unwrap()), 3)
+
+	unsigned, err := ToSliceOf[uint64]([]any{"18446744073709551615", 1.5e19, -1})
+	assert.DeepEqual(t, unsigned, []uint64{math.MaxUint64, 15000000000000000000, 0})
+	assert.ErrorContains(t, err, "index 2:")
+
+	_, err = ToSliceOf[int8]([]any{"x"})
+	assert.ErrorContains(t, err, `"x" to int8`)
+
+	_, err = ToSliceOf[time.Time]([]any{"2024-01-01"})
+	assert.Assert(t, errors.Is(err, ErrInvalidConversion))
+}
+
+func TestMustConversions(t *testing.T) {
+	assert.Equal(t, MustToString(1), "1")
+	assert.Equal(t, MustToNumber("2"), 2.0)
+	assert.Equal(t, MustToInt("3"), 3)
+	assert.Equal(t, MustToBool(1), true)
+	assert.DeepEqual(t, MustToSlice([]int{1}), []any{1})
+
+	defer func() {
+		assert.Assert(t, errors.Is(recover().(error), ErrInvalidConversion))
+	}()
+
+	MustToNumber("12px")
+}
+
+func ExampleToNumber() {
+	for _, value := range []any{"12", "12px", true, nil} {
+		number, err := ToNumber(value)
+		fmt.Println(number, err)
+	}
+	// Output:
+	// 12 <nil>
+	// 0 godash: invalid conversion: "12px" to number
+	// 1 <nil>
+	// 0 <nil>
+}
@@ -0,0 +1,166 @@
+package godash
+
+const dequeMinCapacity = 8
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// Pushing and popping at both ends is amortized O(1), indexed access is O(1).
+// The zero value is an empty deque ready to use.
+type Deque[E any] struct {
+	items []E
+	head  int
+	count int
+}
+
+// Creates a deque containing the given items, from front to back.
+func NewDeque[E any](items ...E) *Deque[E] {
+	capacity := dequeMinCapacity
+	for capacity < len(items) {
+		capacity *= 2
+	}
+
+	deque := &Deque[E]{items: make([]E, capacity)}
+	copy(deque.items, items)
+	deque.count = len(items)
+	return deque
+}
+
+func (d *Deque[E]) index(i int) int {
+	return (d.head + i) & (len(d.items) - 1)
+}
+
+func (d *Deque[E]) resize(capacity int) {
+	items := make([]E, capacity)
+	d.copyTo(items)
+	d.items = items
+	d.head = 0
+}
+
+func (d *Deque[E]) copyTo(dst []E) {
+	if d.count == 0 {
+		return
+	}
+
+	tail := d.head + d.count
+	if tail <= len(d.items) {
+		copy(dst, d.items[d.head:tail])
+	} else {
+		n := copy(dst, d.items[d.head:])
+		copy(dst[n:], d.items[:tail-len(d.items)])
+	}
+}
+
+func (d *Deque[E]) grow() {
+	if len(d.items) == 0 {
+		d.items = make([]E, dequeMinCapacity)
+	} else if d.count == len(d.items) {
+		d.resize(len(d.items) * 2)
+	}
+}
+
+func (d *Deque[E]) shrink() {
+	if len(d.items) > dequeMinCapacity && d.count <= len(d.items)/4 {
+		d.resize(len(d.items) / 2)
+	}
+}
+
+// Gets the count of items in the deque.
+func (d *Deque[E]) Len() int {
+	return d.count
+}
+
+// Appends item to the back of the deque.
+func (d *Deque[E]) PushBack(item E) {
+	d.grow()
+	d.items[d.index(d.count)] = item
+	d.count++
+}
+
+// Prepends item to the front of the deque.
+func (d *Deque[E]) PushFront(item E) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.items) - 1)
+	d.items[d.head] = item
+	d.count++
+}
+
+// Removes and returns the item at the back of the deque.
+func (d *Deque[E]) PopBack() (item E, ok bool) {
+	if d.count == 0 {
+		return
+	}
+
+	d.count--
+	index := d.index(d.count)
+	item, ok = d.items[index], true
+
+	var zero E
+	d.items[index] = zero
+	d.shrink()
+	return
+}
+
+// Removes and returns the item at the front of the deque.
+func (d *Deque[E]) PopFront() (item E, ok bool) {
+	if d.count == 0 {
+		return
+	}
+
+	item, ok = d.items[d.head], true
+
+	var zero E
+	d.items[d.head] = zero
+	d.head = d.index(1)
+	d.count--
+	d.shrink()
+	return
+}
+
+// Gets the item at the front of the deque without removing it.
+func (d *Deque[E]) Front() (item E, ok bool) {
+	if d.count > 0 {
+		item, ok = d.items[d.head], true
+	}
+
+	return
+}
+
+// Gets the item at the back of the deque without removing it.
+func (d *Deque[E]) Back() (item E, ok bool) {
+	if d.count > 0 {
+		item, ok = d.items[d.index(d.count-1)], true
+	}
+
+	return
+}
+
+// Gets the item at index i, where 0 is the front. It panics if i is out of range.
+func (d *Deque[E]) At(i int) E {
+	if i < 0 || i >= d.count {
+		panic("godash: deque index out of range")
+	}
+
+	return d.items[d.index(i)]
+}
+
+// Sets the item at index i, where 0 is the front. It panics if i is out of range.
+func (d *Deque[E]) Set(i int, item E) {
+	if i < 0 || i >= d.count {
+		panic("godash: deque index out of range")
+	}
+
+	d.items[d.index(i)] = item
+}
+
+// Removes all items from the deque.
+func (d *Deque[E]) Clear() {
+	d.items = nil
+	d.head = 0
+	d.count = 0
+}
+
+// Copies the items from front to back into a new slice, so the array functions can be applied.
+func (d *Deque[E]) Slice() []E {
+	result := make([]E, d.count)
+	d.copyTo(result)
+	return result
+}
@@ -0,0 +1,83 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestDequePushPop(t *testing.T) {
+	var d Deque[int]
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	assert.DeepEqual(t, d.Slice(), []int{0, 1, 2, 3})
+	assert.Equal(t, d.At(1), 1)
+
+	item, ok := d.PopFront()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, item, 0)
+
+	item, ok = d.PopBack()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, item, 3)
+
+	front, _ := d.Front()
+	back, _ := d.Back()
+	assert.Equal(t, front, 1)
+	assert.Equal(t, back, 2)
+	assert.Equal(t, d.Len(), 2)
+
+	d.Clear()
+	_, ok = d.PopBack()
+	assert.Equal(t, ok, false)
+	_, ok = d.Front()
+	assert.Equal(t, ok, false)
+}
+
+func TestDequeGrowAndShrink(t *testing.T) {
+	d := NewDeque(1, 2, 3)
+	expected := []int{1, 2, 3}
+
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+			expected = append(expected, i)
+		} else {
+			d.PushFront(i)
+			expected = append([]int{i}, expected...)
+		}
+	}
+
+	assert.DeepEqual(t, d.Slice(), expected)
+
+	for i := 0; i < 90; i++ {
+		d.PopFront()
+	}
+
+	assert.DeepEqual(t, d.Slice(), expected[90:])
+	assert.Assert(t, len(d.items) < 64)
+}
+
+func TestDequeAtOutOfRange(t *testing.T) {
+	d := NewDeque(1)
+	defer func() {
+		assert.Assert(t, recover() != nil)
+	}()
+
+	d.At(1)
+}
+
+func ExampleDeque() {
+	d := NewDeque("b", "c")
+	d.PushFront("a")
+	d.PushBack("d")
+	d.Set(0, "z")
+
+	fmt.Println(Join(d.Slice(), ","))
+	// Output:
+	// z,b,c,d
+}
@@ -0,0 +1,461 @@
+package godash
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// EqualOption customizes how IsEqualWith and Diff compare values.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	ignoredFields  map[string]bool
+	floatTolerance float64
+	equateNaN      bool
+	unordered      bool
+	nilEqualsEmpty bool
+}
+
+// Ignores struct fields by path. A path is the dot separated names of the fields from the root value,
+// where slice, array and map levels are skipped, e.g. "Items.Price" matches the Price of every element of Items.
+func IgnoreFields(paths ...string) EqualOption {
+	return func(config *equalConfig) {
+		if config.ignoredFields == nil {
+			config.ignoredFields = map[string]bool{}
+		}
+
+		for _, path := range paths {
+			config.ignoredFields[path] = true
+		}
+	}
+}
+
+// Treats floats as equal when their difference is not greater than tolerance.
+func FloatTolerance(tolerance float64) EqualOption {
+	return func(config *equalConfig) {
+		config.floatTolerance = math.Abs(tolerance)
+	}
+}
+
+// Treats NaN as equal to NaN.
+func EquateNaN() EqualOption {
+	return func(config *equalConfig) {
+		config.equateNaN = true
+	}
+}
+
+// Compares slices as multisets, ignoring the order of their elements. Arrays are still compared in order.
+func UnorderedSlices() EqualOption {
+	return func(config *equalConfig) {
+		config.unordered = true
+	}
+}
+
+// Treats nil slices and maps as equal to empty ones.
+func NilEqualsEmpty() EqualOption {
+	return func(config *equalConfig) {
+		config.nilEqualsEmpty = true
+	}
+}
+
+// DiffEntry is a difference found by Diff.
+type DiffEntry struct {
+	// The path of the value from the root, e.g. "Items[0].Name" or `Tags["color"]`. It is empty for the root value.
+	Path string
+	// The value in the first argument. It is nil when the value only exists in the second argument.
+	A any
+	// The value in the second argument. It is nil when the value only exists in the first argument.
+	B any
+}
+
+// Returns a string like "Items[0].Name: a != b".
+func (d DiffEntry) String() string {
+	path := d.Path
+	if path == "" {
+		path = "(root)"
+	}
+
+	return fmt.Sprintf("%s: %v != %v", path, d.A, d.B)
+}
+
+type equalVisit struct {
+	a, b   uintptr
+	typ    reflect.Type
+	length int
+}
+
+type equalState struct {
+	config  equalConfig
+	diffs   []DiffEntry
+	collect bool
+	visited map[equalVisit]bool
+}
+
+func newEqualState(options []EqualOption, collect bool) *equalState {
+	state := &equalState{collect: collect}
+
+	for _, option := range options {
+		option(&state.config)
+	}
+
+	return state
+}
+
+// Performs a deep comparison between two values to determine if they are equivalent.
+func IsEqual(a any, b any) bool {
+	return IsEqualWith(a, b)
+}
+
+// This method is like IsEqual except that it accepts options to customize the comparison.
+func IsEqualWith(a any, b any, options ...EqualOption) bool {
+	state := newEqualState(options, false)
+	return state.compare("", "", reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// Performs a deep comparison between two values and returns the differences in traversal order,
+// where map keys are visited in sorted order.
+// An empty result means the values are equivalent.
+func Diff(a any, b any, options ...EqualOption) []DiffEntry {
+	state := newEqualState(options, true)
+	state.compare("", "", reflect.ValueOf(a), reflect.ValueOf(b))
+
+	if state.diffs == nil {
+		return []DiffEntry{}
+	}
+
+	return state.diffs
+}
+
+// Checks if two values are equal using SameValueZero for equality comparisons. Values are compared deeply like IsEqual,
+// except that NaN is equal to NaN. +0 and -0 are equal.
+func SameValueZero[E any](a E, b E) bool {
+	switch x := any(a).(type) {
+	case int:
+		y, ok := any(b).(int)
+		return ok && x == y
+	case string:
+		y, ok := any(b).(string)
+		return ok && x == y
+	case float64:
+		y, ok := any(b).(float64)
+		return ok && (x == y || math.IsNaN(x) && math.IsNaN(y))
+	case float32:
+		y, ok := any(b).(float32)
+		return ok && (x == y || x != x && y != y)
+	}
+
+	return IsEqualWith(a, b, EquateNaN())
+}
+
+// plainKind classifies the types whose values are equal by SameValueZero exactly when their memory is equal,
+// so they can be compared without reflection.
+type plainKind int
+
+const (
+	notPlain plainKind = iota
+	plainString
+	plainScalar
+)
+
+// Gets the plain kind of E and the size of its values. Types implementing Equaler or Hasher are not plain.
+func plainKindOf[E any]() (plainKind, uintptr) {
+	t := reflect.TypeFor[E]()
+	if t.Implements(reflect.TypeFor[Equaler[E]]()) || t.Implements(reflect.TypeFor[Hasher]()) {
+		return notPlain, 0
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return plainString, t.Size()
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return plainScalar, t.Size()
+	default:
+		return notPlain, 0
+	}
+}
+
+// Reads the string of a value of plain string kind.
+func plainStringOf[E any](item *E) string {
+	return *(*string)(unsafe.Pointer(item))
+}
+
+// Reads the bits of a value of plain scalar kind, whose size is not greater than 8 bytes.
+func plainScalarOf[E any](item *E, size uintptr) uint64 {
+	var bits uint64
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&bits)), size), unsafe.Slice((*byte)(unsafe.Pointer(item)), size))
+	return bits
+}
+
+// Creates a function checking if an item is equal to element using equalOf.
+// Plain values are compared directly, which is much faster than equalOf.
+func equalTo[E any](element E) func(item *E) bool {
+	switch kind, size := plainKindOf[E](); kind {
+	case plainString:
+		target := plainStringOf(&element)
+		return func(item *E) bool {
+			return plainStringOf(item) == target
+		}
+	case plainScalar:
+		target := plainScalarOf(&element, size)
+		return func(item *E) bool {
+			return plainScalarOf(item, size) == target
+		}
+	default:
+		return func(item *E) bool {
+			return equalOf(*item, element)
+		}
+	}
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	} else if v.CanInterface() {
+		return v.Interface()
+	}
+
+	return fmt.Sprint(v)
+}
+
+func (s *equalState) report(path string, a reflect.Value, b reflect.Value) bool {
+	if s.collect {
+		s.diffs = append(s.diffs, DiffEntry{path, interfaceOf(a), interfaceOf(b)})
+	}
+
+	return false
+}
+
+func isNilOrEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+func (s *equalState) compare(path string, fieldPath string, a reflect.Value, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() == b.IsValid() {
+			return true
+		}
+
+		return s.report(path, a, b)
+	}
+
+	if a.Type() != b.Type() {
+		return s.report(path, a, b)
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() || a.Pointer() == b.Pointer() {
+			return a.IsNil() == b.IsNil() || s.report(path, a, b)
+		}
+
+		if s.visit(a, b) {
+			return true
+		}
+
+		return s.compare(path, fieldPath, a.Elem(), b.Elem())
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil() || s.report(path, a, b)
+		}
+
+		return s.compare(path, fieldPath, a.Elem(), b.Elem())
+	case reflect.Slice, reflect.Map:
+		if a.IsNil() != b.IsNil() {
+			if s.config.nilEqualsEmpty && isNilOrEmpty(a) && isNilOrEmpty(b) {
+				return true
+			}
+
+			return s.report(path, a, b)
+		}
+
+		if a.Pointer() == b.Pointer() && a.Len() == b.Len() || s.visit(a, b) {
+			return true
+		}
+
+		if a.Kind() == reflect.Map {
+			return s.compareMap(path, fieldPath, a, b)
+		} else if s.config.unordered {
+			return s.compareUnordered(path, fieldPath, a, b)
+		}
+
+		return s.compareSequence(path, fieldPath, a, b)
+	case reflect.Array:
+		return s.compareSequence(path, fieldPath, a, b)
+	case reflect.Struct:
+		return s.compareStruct(path, fieldPath, a, b)
+	case reflect.Float32, reflect.Float64:
+		return s.equalFloat(a.Float(), b.Float()) || s.report(path, a, b)
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := a.Complex(), b.Complex()
+		return s.equalFloat(real(ca), real(cb)) && s.equalFloat(imag(ca), imag(cb)) || s.report(path, a, b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int() || s.report(path, a, b)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint() || s.report(path, a, b)
+	case reflect.String:
+		return a.String() == b.String() || s.report(path, a, b)
+	case reflect.Bool:
+		return a.Bool() == b.Bool() || s.report(path, a, b)
+	case reflect.Func:
+		return a.IsNil() && b.IsNil() || s.report(path, a, b)
+	default:
+		return a.Pointer() == b.Pointer() || s.report(path, a, b)
+	}
+}
+
+// Records the comparison of the pointers, maps or slices a and b, and reports whether they were already compared.
+// Cyclic values are equal when no difference is found before the comparison returns to a visited pair.
+func (s *equalState) visit(a reflect.Value, b reflect.Value) bool {
+	visit := equalVisit{a.Pointer(), b.Pointer(), a.Type(), 0}
+	if a.Kind() == reflect.Slice {
+		visit.length = a.Len()
+	}
+
+	if s.visited[visit] {
+		return true
+	} else if s.visited == nil {
+		s.visited = map[equalVisit]bool{}
+	}
+
+	s.visited[visit] = true
+	return false
+}
+
+func (s *equalState) equalFloat(a float64, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return s.config.equateNaN && math.IsNaN(a) && math.IsNaN(b)
+	}
+
+	return a == b || math.Abs(a-b) <= s.config.floatTolerance
+}
+
+func (s *equalState) compareSequence(path string, fieldPath string, a reflect.Value, b reflect.Value) bool {
+	equal := true
+	length := a.Len()
+	if b.Len() > length {
+		length = b.Len()
+	}
+
+	for i := 0; i < length; i++ {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+
+		var ea, eb reflect.Value
+		if i < a.Len() {
+			ea = a.Index(i)
+		}
+
+		if i < b.Len() {
+			eb = b.Index(i)
+		}
+
+		if !s.compare(itemPath, fieldPath, ea, eb) {
+			if equal = false; !s.collect {
+				return false
+			}
+		}
+	}
+
+	return equal
+}
+
+func (s *equalState) compareUnordered(path string, fieldPath string, a reflect.Value, b reflect.Value) bool {
+	matched := make([]bool, b.Len())
+	unmatched := []int{}
+
+	for i := 0; i < a.Len(); i++ {
+		found := false
+		for j := 0; j < b.Len(); j++ {
+			if matched[j] {
+				continue
+			}
+
+			// Probes inherit the visited pairs so that cyclic elements terminate. They use a copy,
+			// so pairs visited by a failed probe are compared again.
+			probe := &equalState{config: s.config, visited: maps.Clone(s.visited)}
+			if probe.compare("", fieldPath, a.Index(i), b.Index(j)) {
+				matched[j], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			if !s.collect {
+				return false
+			}
+
+			unmatched = append(unmatched, i)
+		}
+	}
+
+	equal := len(unmatched) == 0
+	for _, i := range unmatched {
+		s.report(fmt.Sprintf("%s[%d]", path, i), a.Index(i), reflect.Value{})
+	}
+
+	for j, ok := range matched {
+		if !ok {
+			if equal = false; !s.collect {
+				return false
+			}
+
+			s.report(fmt.Sprintf("%s[%d]", path, j), reflect.Value{}, b.Index(j))
+		}
+	}
+
+	return equal
+}
+
+func (s *equalState) compareMap(path string, fieldPath string, a reflect.Value, b reflect.Value) bool {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessValue(keys[i], keys[j])
+	})
+
+	equal := true
+	for _, key := range keys {
+		itemPath := fmt.Sprintf("%s[%#v]", path, interfaceOf(key))
+		if !s.compare(itemPath, fieldPath, a.MapIndex(key), b.MapIndex(key)) {
+			if equal = false; !s.collect {
+				return false
+			}
+		}
+	}
+
+	return equal
+}
+
+func (s *equalState) compareStruct(path string, fieldPath string, a reflect.Value, b reflect.Value) bool {
+	equal := true
+	for i := 0; i < a.NumField(); i++ {
+		name := a.Type().Field(i).Name
+		fieldName := strings.TrimPrefix(fieldPath+"."+name, ".")
+		if s.config.ignoredFields[fieldName] {
+			continue
+		}
+
+		if !s.compare(strings.TrimPrefix(path+"."+name, "."), fieldName, a.Field(i), b.Field(i)) {
+			if equal = false; !s.collect {
+				return false
+			}
+		}
+	}
+
+	return equal
+}
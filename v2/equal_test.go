@@ -0,0 +1,168 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+type equalItem struct {
+	Name  string
+	Price float64
+	Tags  []string
+}
+
+type equalKey struct {
+	ID int
+}
+
+type equalHidden struct {
+	counts map[equalKey]int
+}
+
+type equalOrder struct {
+	ID      int
+	Items   []equalItem
+	Meta    map[string]any
+	Updated string
+}
+
+func TestIsEqual(t *testing.T) {
+	assert.Equal(t, IsEqual([]int{1, 2}, []int{1, 2}), true)
+	assert.Equal(t, IsEqual([]int{1, 2}, []int{2, 1}), false)
+	assert.Equal(t, IsEqual(map[string]int{"a": 1}, map[string]int{"a": 1}), true)
+	assert.Equal(t, IsEqual(1, int64(1)), false)
+	assert.Equal(t, IsEqual(nil, nil), true)
+	assert.Equal(t, IsEqual([]int(nil), []int{}), false)
+	assert.Equal(t, IsEqual(math.NaN(), math.NaN()), false)
+}
+
+func TestIsEqualWithOptions(t *testing.T) {
+	assert.Equal(t, IsEqualWith([]int(nil), []int{}, NilEqualsEmpty()), true)
+	assert.Equal(t, IsEqualWith(map[string]int{}, map[string]int(nil), NilEqualsEmpty()), true)
+	assert.Equal(t, IsEqualWith(math.NaN(), math.NaN(), EquateNaN()), true)
+	assert.Equal(t, IsEqualWith(0.1+0.2, 0.3, FloatTolerance(1e-9)), true)
+	assert.Equal(t, IsEqualWith(0.1, 0.2, FloatTolerance(1e-9)), false)
+	assert.Equal(t, IsEqualWith([]int{1, 2, 2}, []int{2, 1, 2}, UnorderedSlices()), true)
+	assert.Equal(t, IsEqualWith([]int{1, 2, 2}, []int{2, 1, 1}, UnorderedSlices()), false)
+}
+
+func TestIsEqualIgnoreFields(t *testing.T) {
+	o1 := equalOrder{ID: 1, Items: []equalItem{{"a", 1, nil}}, Updated: "today"}
+	o2 := equalOrder{ID: 1, Items: []equalItem{{"a", 2, nil}}, Updated: "yesterday"}
+
+	assert.Equal(t, IsEqualWith(o1, o2), false)
+	assert.Equal(t, IsEqualWith(o1, o2, IgnoreFields("Updated")), false)
+	assert.Equal(t, IsEqualWith(o1, o2, IgnoreFields("Updated", "Items.Price")), true)
+}
+
+func TestIsEqualCyclic(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+
+	a := &node{}
+	a.Next = a
+	b := &node{}
+	b.Next = b
+
+	assert.Equal(t, IsEqual(a, b), true)
+}
+
+func TestIsEqualCyclicMapAndSlice(t *testing.T) {
+	m1 := map[string]any{"name": "a"}
+	m1["self"] = m1
+	m2 := map[string]any{"name": "a"}
+	m2["self"] = m2
+	m3 := map[string]any{"name": "b"}
+	m3["self"] = m3
+
+	assert.Equal(t, IsEqual(m1, m1), true)
+	assert.Equal(t, IsEqual(m1, m2), true)
+	assert.Equal(t, IsEqual(m1, m3), false)
+	assert.Equal(t, len(Diff(m1, m3)), 1)
+
+	s1 := []any{1, nil}
+	s1[1] = s1
+	s2 := []any{1, nil}
+	s2[1] = s2
+	s3 := []any{2, nil}
+	s3[1] = s3
+
+	assert.Equal(t, IsEqual(s1, s2), true)
+	assert.Equal(t, IsEqual(s1, s3), false)
+	assert.Equal(t, IsEqualWith(s1, s2, UnorderedSlices()), true)
+}
+
+func TestIsEqualUnexportedMapKeys(t *testing.T) {
+	a := equalHidden{map[equalKey]int{{1}: 1, {2}: 2}}
+	b := equalHidden{map[equalKey]int{{1}: 1, {2}: 2}}
+	c := equalHidden{map[equalKey]int{{1}: 1, {2}: 3}}
+
+	assert.Equal(t, IsEqual(a, b), true)
+	assert.Equal(t, IsEqual(a, c), false)
+	assert.DeepEqual(t, Diff(a, c), []DiffEntry{{`counts["{2}"]`, "2", "3"}})
+}
+
+func TestDiff(t *testing.T) {
+	o1 := equalOrder{
+		ID:    1,
+		Items: []equalItem{{"a", 1, []string{"x"}}, {"b", 2, nil}},
+		Meta:  map[string]any{"color": "red", "size": 1},
+	}
+	o2 := equalOrder{
+		ID:    2,
+		Items: []equalItem{{"a", 1, []string{"y"}}},
+		Meta:  map[string]any{"color": "blue", "weight": 2},
+	}
+
+	diffs := Diff(o1, o2)
+	assert.DeepEqual(t, diffs, []DiffEntry{
+		{"ID", 1, 2},
+		{"Items[0].Tags[0]", "x", "y"},
+		{"Items[1]", equalItem{"b", 2, nil}, nil},
+		{`Meta["color"]`, "red", "blue"},
+		{`Meta["size"]`, 1, nil},
+		{`Meta["weight"]`, nil, 2},
+	})
+
+	assert.DeepEqual(t, Diff(o1, o1), []DiffEntry{})
+}
+
+func TestDiffUnordered(t *testing.T) {
+	diffs := Diff([]string{"a", "b", "c"}, []string{"c", "d", "a"}, UnorderedSlices())
+
+	assert.DeepEqual(t, diffs, []DiffEntry{{"[1]", "b", nil}, {"[1]", nil, "d"}})
+}
+
+func ExampleDiff() {
+	type user struct {
+		Name  string
+		Score float64
+	}
+
+	diffs := Diff(user{"Jerry", 1.0}, user{"Tom", 1.00001}, FloatTolerance(0.001))
+	for _, diff := range diffs {
+		fmt.Println(diff)
+	}
+	// Output:
+	// Name: Jerry != Tom
+}
+
+func TestSameValueZero(t *testing.T) {
+	nan64, nan32 := math.NaN(), float32(math.NaN())
+	negZero := math.Copysign(0, -1)
+
+	assert.Equal(t, SameValueZero(nan64, nan64), true)
+	assert.Equal(t, SameValueZero(nan32, nan32), true)
+	assert.Equal(t, SameValueZero(negZero, 0.0), true)
+	assert.Equal(t, SameValueZero(float32(negZero), float32(0)), true)
+	assert.Equal(t, SameValueZero(nan64, 0.0), false)
+	assert.Equal(t, SameValueZero(1, 1), true)
+	assert.Equal(t, SameValueZero[any](1, 1.0), false)
+	assert.Equal(t, SameValueZero[any](nan64, nan64), true)
+	assert.Equal(t, SameValueZero([]float64{1, nan64}, []float64{1, nan64}), true)
+	assert.Equal(t, SameValueZero(struct{ X float32 }{nan32}, struct{ X float32 }{nan32}), true)
+}
@@ -0,0 +1,55 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Returned by the Checked functions when a size or count argument is not valid.
+var ErrInvalidSize = errors.New("godash: invalid size")
+
+// Returned by the Checked functions when an index is out of the array bounds.
+var ErrOutOfRange = errors.New("godash: index out of range")
+
+// Returned by the conversion functions when a value can't be converted to the target type.
+var ErrInvalidConversion = errors.New("godash: invalid conversion")
+
+func invalidSizeError(name string, size int) error {
+	return fmt.Errorf("%w: %s %d", ErrInvalidSize, name, size)
+}
+
+func outOfRangeError(index int, length int) error {
+	return fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, index, length)
+}
+
+func conversionError(value any, target string) error {
+	return fmt.Errorf("%w: %#v to %s", ErrInvalidConversion, value, target)
+}
+
+// Converts a negative index to the offset from the end and clamps it to [0, length].
+func clampIndex(index int, length int) int {
+	if index < 0 {
+		index += length
+	}
+
+	if index < 0 {
+		return 0
+	} else if index > length {
+		return length
+	}
+
+	return index
+}
+
+// Converts a negative index to the offset from the end. Returns ErrOutOfRange if it is not in [-length, length].
+func checkIndex(index int, length int) (int, error) {
+	if index < -length || index > length {
+		return 0, outOfRangeError(index, length)
+	}
+
+	if index < 0 {
+		index += length
+	}
+
+	return index, nil
+}
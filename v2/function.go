@@ -0,0 +1,124 @@
+package godash
+
+// Creates a function that returns the result of invoking the given functions from left to right.
+// The result of f is passed to g.
+func Flow2[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// This method is like Flow2 except that it chains three functions.
+func Flow3[A, B, C, D any](f func(A) B, g func(B) C, h func(C) D) func(A) D {
+	return func(a A) D {
+		return h(g(f(a)))
+	}
+}
+
+// This method is like Flow2 except that it chains four functions.
+func Flow4[A, B, C, D, E any](f func(A) B, g func(B) C, h func(C) D, i func(D) E) func(A) E {
+	return func(a A) E {
+		return i(h(g(f(a))))
+	}
+}
+
+// This method is like Flow2 except that it chains five functions.
+func Flow5[A, B, C, D, E, F any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F) func(A) F {
+	return func(a A) F {
+		return j(i(h(g(f(a)))))
+	}
+}
+
+// Creates a function that returns the result of invoking the given functions from left to right.
+// All functions share the same input and output type, so any number of them can be chained.
+// Without any function, the returned function is the identity.
+func Flow[E any](funcs ...func(E) E) func(E) E {
+	return func(e E) E {
+		for _, f := range funcs {
+			e = f(e)
+		}
+
+		return e
+	}
+}
+
+// This method is like Flow2 except that it invokes the given functions from right to left.
+// The result of g is passed to f.
+func Compose2[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return Flow2(g, f)
+}
+
+// This method is like Compose2 except that it composes three functions.
+func Compose3[A, B, C, D any](f func(C) D, g func(B) C, h func(A) B) func(A) D {
+	return Flow3(h, g, f)
+}
+
+// This method is like Compose2 except that it composes four functions.
+func Compose4[A, B, C, D, E any](f func(D) E, g func(C) D, h func(B) C, i func(A) B) func(A) E {
+	return Flow4(i, h, g, f)
+}
+
+// This method is like Compose2 except that it composes five functions.
+func Compose5[A, B, C, D, E, F any](f func(E) F, g func(D) E, h func(C) D, i func(B) C, j func(A) B) func(A) F {
+	return Flow5(j, i, h, g, f)
+}
+
+// This method is like Flow except that it invokes the given functions from right to left.
+func Compose[E any](funcs ...func(E) E) func(E) E {
+	return func(e E) E {
+		for i := len(funcs) - 1; i >= 0; i-- {
+			e = funcs[i](e)
+		}
+
+		return e
+	}
+}
+
+// Creates a function that invokes fn with a prepended to the argument it receives.
+func Partial[A, B, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// This method is like Partial except that b is appended to the argument the function receives.
+func PartialRight[A, B, R any](fn func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return fn(a, b)
+	}
+}
+
+// Creates a function that invokes fn with a and b prepended to the argument it receives.
+func Partial3[A, B, C, R any](fn func(A, B, C) R, a A, b B) func(C) R {
+	return func(c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// This method is like Partial3 except that b and c are appended to the argument the function receives.
+// e.g. PartialRight3(PadLeftWith, 5, "0") creates an iteratee that pads every string to 5 chars with "0".
+func PartialRight3[A, B, C, R any](fn func(A, B, C) R, b B, c C) func(A) R {
+	return func(a A) R {
+		return fn(a, b, c)
+	}
+}
+
+// Creates a function that accepts the first argument of fn and returns a function accepting the second one.
+func Curry2[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return fn(a, b)
+		}
+	}
+}
+
+// This method is like Curry2 except that fn accepts three arguments.
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
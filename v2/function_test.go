@@ -0,0 +1,95 @@
+package godash
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestFlow2(t *testing.T) {
+	toString := Flow2(func(i int) int { return i * 2 }, strconv.Itoa)
+
+	assert.Equal(t, toString(21), "42")
+}
+
+func TestFlow5(t *testing.T) {
+	inc := func(i int) int { return i + 1 }
+	pipeline := Flow5(inc, inc, strconv.Itoa, strings.NewReader, func(r *strings.Reader) int64 { return r.Size() })
+
+	assert.Equal(t, pipeline(98), int64(3))
+}
+
+func TestFlow(t *testing.T) {
+	pipeline := Flow(strings.TrimSpace, strings.ToUpper)
+	assert.Equal(t, pipeline(" abc "), "ABC")
+
+	identity := Flow[string]()
+	assert.Equal(t, identity(" abc "), " abc ")
+}
+
+func ExampleFlow3() {
+	pipeline := Flow3(strings.TrimSpace, strings.ToUpper, func(s string) []string {
+		return Split(s, ",")
+	})
+	fmt.Println(pipeline(" a,b,c "))
+	// Output:
+	// [A B C]
+}
+
+func TestCompose(t *testing.T) {
+	appendA := func(s string) string { return s + "a" }
+	appendB := func(s string) string { return s + "b" }
+
+	assert.Equal(t, Compose(appendA, appendB)(""), "ba")
+	assert.Equal(t, Flow(appendA, appendB)(""), "ab")
+}
+
+func TestCompose3(t *testing.T) {
+	pipeline := Compose3(strconv.Itoa, func(s string) int { return len(s) }, strings.TrimSpace)
+
+	assert.Equal(t, pipeline("  abc  "), "3")
+}
+
+func TestPartial(t *testing.T) {
+	hasPrefix := Partial(StartsWith, "godash")
+
+	assert.Equal(t, hasPrefix("go"), true)
+	assert.Equal(t, hasPrefix("dash"), false)
+}
+
+func TestPartialRight(t *testing.T) {
+	startsWithGo := PartialRight(StartsWith, "go")
+	result := Filter([]string{"godash", "lodash", "gopher"}, startsWithGo)
+
+	assert.DeepEqual(t, result, []string{"godash", "gopher"})
+}
+
+func TestPartial3(t *testing.T) {
+	split := Partial3(SplitWithCountLimit, "a,b,c,d", ",")
+
+	assert.DeepEqual(t, split(2), []string{"a", "b"})
+}
+
+func ExamplePartialRight3() {
+	padZero := PartialRight3(PadLeftWith, 3, "0")
+	result := Map([]string{"1", "22", "333"}, padZero)
+	fmt.Println(result)
+	// Output:
+	// [001 022 333]
+}
+
+func TestCurry2(t *testing.T) {
+	join := Curry2(Join[string])
+	result := Map([]string{"-", "+"}, join([]string{"a", "b"}))
+
+	assert.DeepEqual(t, result, []string{"a-b", "a+b"})
+}
+
+func TestCurry3(t *testing.T) {
+	pad := Curry3(PadRightWith)
+
+	assert.Equal(t, pad("a")(3)("*"), "a**")
+}
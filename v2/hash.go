@@ -0,0 +1,351 @@
+package godash
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// Equaler is implemented by types with custom equality, e.g. func (m Money) Equal(other Money) bool.
+// Functions comparing elements without a comparator, such as IndexOf, Uniq and Difference, use the Equal method
+// instead of SameValueZero. Types implementing Equaler should implement Hasher as well, otherwise they are compared
+// linearly.
+type Equaler[T any] interface {
+	Equal(other T) bool
+}
+
+// Hasher is implemented by types that compute their own hash. Values that are equal must have the same hash.
+type Hasher interface {
+	Hash() uint64
+}
+
+var hashSeed = maphash.MakeSeed()
+
+// Computes the hash of value. Values implementing Hasher are hashed by their Hash method, other values are hashed
+// structurally, consistently with SameValueZero: NaN values share a hash, and so do +0 and -0.
+// Hashes are only stable within the running process.
+func Hash[E any](value E) uint64 {
+	switch v := any(value).(type) {
+	case Hasher:
+		return v.Hash()
+	case string:
+		return maphash.String(hashSeed, v)
+	case int:
+		return hashUint64(uint64(v))
+	case float64:
+		return hashUint64(math.Float64bits(normalizeFloat(v)))
+	}
+
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+
+	w := hashWriter{hash: &h}
+	w.write(reflect.ValueOf(&value).Elem())
+	if w.cyclic {
+		return maphash.String(hashSeed, reflect.TypeOf(&value).Elem().String())
+	}
+
+	return h.Sum64()
+}
+
+func hashUint64(u uint64) uint64 {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], u)
+	return maphash.Bytes(hashSeed, b[:])
+}
+
+type hashVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type hashWriter struct {
+	hash   *maphash.Hash
+	path   map[hashVisit]bool
+	cyclic bool
+}
+
+// Records that the pointer, map or slice v is being written, or sets cyclic and returns false if it already is.
+func (w *hashWriter) enter(v reflect.Value) bool {
+	visit := hashVisit{v.Pointer(), v.Type()}
+	if w.path[visit] {
+		w.cyclic = true
+		return false
+	} else if w.path == nil {
+		w.path = map[hashVisit]bool{}
+	}
+
+	w.path[visit] = true
+	return true
+}
+
+func (w *hashWriter) leave(v reflect.Value) {
+	delete(w.path, hashVisit{v.Pointer(), v.Type()})
+}
+
+func (w *hashWriter) writeUint64(u uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], u)
+	w.hash.Write(b[:])
+}
+
+// Maps all NaN values to one NaN and -0 to +0, so values equal by SameValueZero have the same bits.
+func normalizeFloat(f float64) float64 {
+	if math.IsNaN(f) {
+		return math.NaN()
+	} else if f == 0 {
+		return 0
+	}
+
+	return f
+}
+
+func (w *hashWriter) writeFloat(f float64) {
+	w.writeUint64(math.Float64bits(normalizeFloat(f)))
+}
+
+// Writes v to the hash. Cyclic values are equal whenever their unrolled values are equal, which can't be hashed
+// structurally, so writing stops at the first cycle and the caller falls back to hashing the type.
+func (w *hashWriter) write(v reflect.Value) {
+	if w.cyclic {
+		return
+	} else if !v.IsValid() {
+		w.hash.WriteByte(0)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			w.hash.WriteByte(1)
+		} else {
+			w.hash.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		w.writeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		w.writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		w.writeFloat(real(v.Complex()))
+		w.writeFloat(imag(v.Complex()))
+	case reflect.String:
+		w.hash.WriteString(v.String())
+	case reflect.Slice:
+		w.writeUint64(uint64(v.Len()))
+		if v.Len() > 0 && w.enter(v) {
+			w.writeElements(v)
+			w.leave(v)
+		}
+	case reflect.Array:
+		w.writeUint64(uint64(v.Len()))
+		w.writeElements(v)
+	case reflect.Map:
+		if v.Len() > 0 && w.enter(v) {
+			w.writeMap(v)
+			w.leave(v)
+		} else {
+			w.writeUint64(0)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			w.write(v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			w.hash.WriteByte(0)
+			return
+		}
+
+		w.hash.WriteString(v.Elem().Type().String())
+		w.write(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			w.hash.WriteByte(0)
+			return
+		}
+
+		if w.enter(v) {
+			w.write(v.Elem())
+			w.leave(v)
+		}
+	case reflect.Func:
+		// Non-nil functions are never equal, so any hash is consistent.
+		w.hash.WriteByte(0)
+	default:
+		w.writeUint64(uint64(v.Pointer()))
+	}
+}
+
+func (w *hashWriter) writeElements(v reflect.Value) {
+	for i := 0; i < v.Len(); i++ {
+		w.write(v.Index(i))
+	}
+}
+
+// Writes the entries of map v independently of their iteration order by summing the hashes of the entries.
+func (w *hashWriter) writeMap(v reflect.Value) {
+	var sum uint64
+	iter := v.MapRange()
+	for iter.Next() {
+		var h maphash.Hash
+		h.SetSeed(hashSeed)
+
+		entry := hashWriter{hash: &h, path: w.path}
+		entry.write(iter.Key())
+		entry.write(iter.Value())
+		w.cyclic = w.cyclic || entry.cyclic
+		w.path = entry.path
+		sum += h.Sum64()
+	}
+
+	w.writeUint64(uint64(v.Len()))
+	w.writeUint64(sum)
+}
+
+// Checks if a and b are equal using the Equal method if either implements Equaler, or SameValueZero otherwise.
+func equalOf[E any](a E, b E) bool {
+	if e, ok := any(a).(Equaler[E]); ok {
+		return e.Equal(b)
+	} else if e, ok := any(b).(Equaler[E]); ok {
+		return e.Equal(a)
+	}
+
+	return SameValueZero(a, b)
+}
+
+// Computes the hash of item. It returns false if item implements Equaler but not Hasher,
+// because its structural hash may be inconsistent with its Equal method.
+func hashOf[E any](item E) (uint64, bool) {
+	switch v := any(item).(type) {
+	case Hasher:
+		return v.Hash(), true
+	case Equaler[E]:
+		return 0, false
+	}
+
+	return Hash(item), true
+}
+
+// hashSet tracks values by their hashes and compares values with the same hash using equalOf.
+// Values that can't be hashed are compared linearly. Plain values are tracked in plain maps instead,
+// which is much faster and allocates nothing per value.
+type hashSet[E any] struct {
+	kind     plainKind
+	size     uintptr
+	strings  map[string]struct{}
+	scalars  map[uint64]struct{}
+	first    map[uint64]E
+	overflow map[uint64][]E
+	unhashed []E
+}
+
+func newHashSet[E any](items ...E) *hashSet[E] {
+	s := &hashSet[E]{}
+	switch s.kind, s.size = plainKindOf[E](); s.kind {
+	case plainString:
+		s.strings = make(map[string]struct{}, len(items))
+	case plainScalar:
+		s.scalars = make(map[uint64]struct{}, len(items))
+	default:
+		s.first = make(map[uint64]E, len(items))
+	}
+
+	for _, item := range items {
+		s.add(item)
+	}
+
+	return s
+}
+
+// Gets the value in the set equal to item.
+func (s *hashSet[E]) get(item E) (E, bool) {
+	switch s.kind {
+	case plainString:
+		_, found := s.strings[plainStringOf(&item)]
+		return item, found
+	case plainScalar:
+		_, found := s.scalars[plainScalarOf(&item, s.size)]
+		return item, found
+	}
+
+	hash, hashed := hashOf(item)
+	return s.lookup(item, hash, hashed)
+}
+
+func (s *hashSet[E]) lookup(item E, hash uint64, hashed bool) (E, bool) {
+	if !hashed {
+		for _, value := range s.first {
+			if equalOf(item, value) {
+				return value, true
+			}
+		}
+
+		for _, values := range s.overflow {
+			if i, ok := FindIndexWith(values, item, equalOf[E]); ok {
+				return values[i], true
+			}
+		}
+	} else if value, ok := s.first[hash]; ok {
+		if equalOf(item, value) {
+			return value, true
+		}
+
+		values := s.overflow[hash]
+		if i, ok := FindIndexWith(values, item, equalOf[E]); ok {
+			return values[i], true
+		}
+	}
+
+	if i, ok := FindIndexWith(s.unhashed, item, equalOf[E]); ok {
+		return s.unhashed[i], true
+	}
+
+	var zero E
+	return zero, false
+}
+
+func (s *hashSet[E]) has(item E) bool {
+	_, found := s.get(item)
+	return found
+}
+
+// Adds item to the set and reports whether it was absent.
+func (s *hashSet[E]) add(item E) bool {
+	switch s.kind {
+	case plainString:
+		return addKey(s.strings, plainStringOf(&item))
+	case plainScalar:
+		return addKey(s.scalars, plainScalarOf(&item, s.size))
+	}
+
+	hash, hashed := hashOf(item)
+	if _, found := s.lookup(item, hash, hashed); found {
+		return false
+	}
+
+	if !hashed {
+		s.unhashed = append(s.unhashed, item)
+	} else if _, ok := s.first[hash]; !ok {
+		s.first[hash] = item
+	} else {
+		if s.overflow == nil {
+			s.overflow = map[uint64][]E{}
+		}
+
+		s.overflow[hash] = append(s.overflow[hash], item)
+	}
+
+	return true
+}
+
+func addKey[K comparable](keys map[K]struct{}, key K) bool {
+	if _, found := keys[key]; found {
+		return false
+	}
+
+	keys[key] = struct{}{}
+	return true
+}
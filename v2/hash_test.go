@@ -0,0 +1,133 @@
+package godash
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+type money struct {
+	Amount   int
+	Currency string
+}
+
+func (m money) Equal(other money) bool {
+	return m.Amount == other.Amount && strings.EqualFold(m.Currency, other.Currency)
+}
+
+func (m money) Hash() uint64 {
+	return Hash(m.Amount) ^ maphash.String(hashSeed, strings.ToUpper(m.Currency))
+}
+
+type countedKey struct {
+	id     int
+	equals *int
+}
+
+func (k countedKey) Equal(other countedKey) bool {
+	*k.equals++
+	return k.id == other.id
+}
+
+func (k countedKey) Hash() uint64 {
+	return Hash(k.id)
+}
+
+type tagged struct {
+	Name string
+	Tags []string
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, Hash(math.NaN()), Hash(-math.NaN()))
+	assert.Equal(t, Hash(math.Copysign(0, -1)), Hash(0.0))
+	assert.Equal(t, Hash(float32(math.Copysign(0, -1))), Hash(float32(0)))
+	assert.Equal(t, Hash(tagged{"a", []string{"x"}}), Hash(tagged{"a", []string{"x"}}))
+	assert.Assert(t, Hash(tagged{"a", []string{"x"}}) != Hash(tagged{"a", []string{"y"}}))
+	assert.Equal(t, Hash(map[string]int{"a": 1, "b": 2}), Hash(map[string]int{"b": 2, "a": 1}))
+	assert.Equal(t, Hash(&tagged{Name: "a"}), Hash(&tagged{Name: "a"}))
+	assert.Equal(t, Hash(money{1, "usd"}), Hash(money{1, "USD"}))
+
+	type node struct{ Next *node }
+	a := &node{}
+	a.Next = a
+	b := &node{}
+	b.Next = &node{Next: b}
+	assert.Equal(t, IsEqual(a, b), true)
+	assert.Equal(t, Hash(a), Hash(b))
+}
+
+func TestHashCyclicMapAndSlice(t *testing.T) {
+	m1 := map[string]any{"name": "a"}
+	m1["self"] = m1
+	m2 := map[string]any{"name": "a"}
+	m2["self"] = m2
+	assert.Equal(t, Hash(m1), Hash(m2))
+
+	s1 := []any{1, nil}
+	s1[1] = s1
+	s2 := []any{1, nil}
+	s2[1] = s2
+	assert.Equal(t, Hash(s1), Hash(s2))
+
+	assert.Equal(t, len(Uniq([]any{m1, m2, s1, s2, 1})), 3)
+	assert.Equal(t, len(Difference([]any{m1, s1, 1}, []any{m2})), 2)
+}
+
+func TestEqualerAndHasher(t *testing.T) {
+	items := []money{{1, "USD"}, {2, "EUR"}, {1, "usd"}, {3, "eur"}}
+
+	assert.DeepEqual(t, Uniq(items), []money{{1, "USD"}, {2, "EUR"}, {3, "eur"}})
+	index, _ := IndexOf(items, money{3, "EUR"})
+	assert.Equal(t, index, 3)
+	index, _ = LastIndexOf(items, money{1, "USD"})
+	assert.Equal(t, index, 2)
+	assert.DeepEqual(t, Difference(items, []money{{1, "usd"}}), []money{{2, "EUR"}, {3, "eur"}})
+	assert.DeepEqual(t, Intersection(items, []money{{2, "eur"}}), []money{{2, "EUR"}})
+	assert.DeepEqual(t, Union([]money{{1, "USD"}}, []money{{1, "usd"}, {2, "USD"}}), []money{{1, "USD"}, {2, "USD"}})
+
+	groups := GroupBy(items, func(m money) money { return money{0, m.Currency} })
+	assert.Equal(t, len(groups), 2)
+	assert.DeepEqual(t, groups.GetAll(money{0, "USD"}), []money{{1, "USD"}, {1, "usd"}})
+	assert.DeepEqual(t, groups.GetAll(money{0, "EUR"}), []money{{2, "EUR"}, {3, "eur"}})
+}
+
+func TestHasherAvoidsQuadraticComparisons(t *testing.T) {
+	equals := 0
+	items := make([]countedKey, 1000)
+	for i := range items {
+		items[i] = countedKey{i % 500, &equals}
+	}
+
+	assert.Equal(t, len(Uniq(items)), 500)
+	assert.Assert(t, equals < 1000, "equals: %d", equals)
+}
+
+func TestNonComparableElements(t *testing.T) {
+	items := []tagged{{"a", []string{"x"}}, {"b", nil}, {"a", []string{"x"}}}
+
+	assert.DeepEqual(t, Uniq(items), items[:2])
+	assert.DeepEqual(t, Difference(items, []tagged{{"b", nil}}), []tagged{items[0], items[2]})
+	assert.DeepEqual(t, UniqBy(items, func(item tagged) []string { return item.Tags }), items[:2])
+}
+
+func TestEqualerWithoutHasher(t *testing.T) {
+	utc := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	local := utc.In(time.FixedZone("UTC+1", 3600))
+
+	assert.Equal(t, len(Uniq([]time.Time{utc, local})), 1)
+	_, found := IndexOf([]time.Time{local}, utc)
+	assert.Equal(t, found, true)
+}
+
+func ExampleEqualer() {
+	prices := []money{{5, "USD"}, {5, "usd"}, {7, "EUR"}}
+	fmt.Println(Uniq(prices))
+	// Output:
+	// [{5 USD} {7 EUR}]
+}
@@ -0,0 +1,110 @@
+package godash
+
+import (
+	"math"
+	"reflect"
+)
+
+// Checks if value is nil. Unlike value == nil, it is true for interfaces holding a nil pointer, map, slice,
+// channel or function, e.g. an error holding a nil *MyError.
+func IsNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// Checks if value is nil or the zero value of its type.
+func IsZero(value any) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// Checks if value is an empty value. Nil values, empty strings, slices, arrays, maps and channels are empty.
+// Structs, numbers and booleans are empty when they are zero values. Non-nil pointers and functions are not empty.
+func IsEmpty(value any) bool {
+	if IsNil(value) {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Func, reflect.UnsafePointer:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
+
+func isKind(value any, kinds ...reflect.Kind) bool {
+	if value == nil {
+		return false
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	for _, k := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks if value is a slice. Arrays are not slices.
+func IsSlice(value any) bool {
+	return isKind(value, reflect.Slice)
+}
+
+// Checks if value is a map.
+func IsMap(value any) bool {
+	return isKind(value, reflect.Map)
+}
+
+// Checks if value is a function.
+func IsFunc(value any) bool {
+	return isKind(value, reflect.Func)
+}
+
+// Checks if value is an integer or floating-point number, including named types such as time.Duration.
+func IsNumber(value any) bool {
+	return isKind(value,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64)
+}
+
+// Checks if value is a string, including named string types.
+func IsString(value any) bool {
+	return isKind(value, reflect.String)
+}
+
+// Checks if value is a struct. Pointers to structs are not structs.
+func IsStruct(value any) bool {
+	return isKind(value, reflect.Struct)
+}
+
+// Checks if value is falsy. The values false, 0, NaN, "" and nil values are falsy.
+func isFalsy(value any) bool {
+	if IsNil(value) {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Kind() == reflect.Bool:
+		return !v.Bool()
+	case IsNumber(value) || IsString(value):
+		return v.IsZero() || v.CanFloat() && math.IsNaN(v.Float())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,96 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+type langError struct{}
+
+func (*langError) Error() string { return "lang" }
+
+func TestIsNil(t *testing.T) {
+	var nilPointer *langError
+	var err error = nilPointer
+	var nilMap map[string]int
+
+	assert.Equal(t, IsNil(nil), true)
+	assert.Equal(t, err != nil, true)
+	assert.Equal(t, IsNil(err), true)
+	assert.Equal(t, IsNil(nilMap), true)
+	assert.Equal(t, IsNil([]int(nil)), true)
+	assert.Equal(t, IsNil((func())(nil)), true)
+	assert.Equal(t, IsNil(errors.New("e")), false)
+	assert.Equal(t, IsNil(0), false)
+	assert.Equal(t, IsNil([]int{}), false)
+}
+
+func TestIsZero(t *testing.T) {
+	assert.Equal(t, IsZero(nil), true)
+	assert.Equal(t, IsZero(0), true)
+	assert.Equal(t, IsZero(""), true)
+	assert.Equal(t, IsZero(struct{ A int }{}), true)
+	assert.Equal(t, IsZero(time.Time{}), true)
+	assert.Equal(t, IsZero([]int{}), false)
+	assert.Equal(t, IsZero(false), true)
+	assert.Equal(t, IsZero(1.5), false)
+}
+
+func TestIsEmpty(t *testing.T) {
+	var nilPointer *langError
+
+	assert.Equal(t, IsEmpty(nil), true)
+	assert.Equal(t, IsEmpty(nilPointer), true)
+	assert.Equal(t, IsEmpty(""), true)
+	assert.Equal(t, IsEmpty([]int{}), true)
+	assert.Equal(t, IsEmpty(map[string]int{}), true)
+	assert.Equal(t, IsEmpty(make(chan int, 1)), true)
+	assert.Equal(t, IsEmpty([0]int{}), true)
+	assert.Equal(t, IsEmpty(struct{ A int }{}), true)
+	assert.Equal(t, IsEmpty(0), true)
+
+	ch := make(chan int, 1)
+	ch <- 1
+	assert.Equal(t, IsEmpty(ch), false)
+	assert.Equal(t, IsEmpty("a"), false)
+	assert.Equal(t, IsEmpty([]int{0}), false)
+	assert.Equal(t, IsEmpty(map[string]int{"a": 0}), false)
+	assert.Equal(t, IsEmpty(struct{ A int }{1}), false)
+	assert.Equal(t, IsEmpty(&struct{}{}), false)
+	assert.Equal(t, IsEmpty(1), false)
+}
+
+func TestTypePredicates(t *testing.T) {
+	assert.Equal(t, IsSlice([]any{}), true)
+	assert.Equal(t, IsSlice([1]int{}), false)
+	assert.Equal(t, IsSlice(nil), false)
+	assert.Equal(t, IsMap(map[string]any{}), true)
+	assert.Equal(t, IsMap(NewMultiMap[string, int]()), true)
+	assert.Equal(t, IsMap([]int{}), false)
+	assert.Equal(t, IsFunc(IsFunc), true)
+	assert.Equal(t, IsFunc("IsFunc"), false)
+	assert.Equal(t, IsNumber(1), true)
+	assert.Equal(t, IsNumber(uint8(1)), true)
+	assert.Equal(t, IsNumber(math.NaN()), true)
+	assert.Equal(t, IsNumber(time.Second), true)
+	assert.Equal(t, IsNumber("1"), false)
+	assert.Equal(t, IsNumber(nil), false)
+	assert.Equal(t, IsString("a"), true)
+	assert.Equal(t, IsString('a'), false)
+	assert.Equal(t, IsStruct(time.Time{}), true)
+	assert.Equal(t, IsStruct(&time.Time{}), false)
+}
+
+func ExampleIsNil() {
+	var nilPointer *langError
+	var err error = nilPointer
+
+	fmt.Println(err == nil, IsNil(err))
+	// Output:
+	// false true
+}
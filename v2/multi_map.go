@@ -0,0 +1,98 @@
+package godash
+
+import (
+	"reflect"
+	"sort"
+)
+
+// MultiMap is a map that associates each key with a list of values.
+// It is a map under the hood, so it can be used as map[K][]V.
+type MultiMap[K comparable, V any] map[K][]V
+
+// Creates an empty multi map.
+func NewMultiMap[K comparable, V any]() MultiMap[K, V] {
+	return MultiMap[K, V]{}
+}
+
+// Appends values to the list of key. Nothing is added when no values are given.
+func (m MultiMap[K, V]) Put(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+
+	m[key] = append(m[key], values...)
+}
+
+// Gets all values of key in the order they were put.
+func (m MultiMap[K, V]) GetAll(key K) []V {
+	return m[key]
+}
+
+// Checks if key has at least one value.
+func (m MultiMap[K, V]) Has(key K) bool {
+	return len(m[key]) > 0
+}
+
+// Removes the first occurrence of value from the list of key. The key is removed once its list is empty.
+// Returns false if value is not found.
+func (m MultiMap[K, V]) Remove(key K, value V) bool {
+	values := m[key]
+	index, ok := IndexOf(values, value)
+	if !ok {
+		return false
+	}
+
+	if len(values) == 1 {
+		delete(m, key)
+	} else {
+		m[key] = append(values[:index:index], values[index+1:]...)
+	}
+
+	return true
+}
+
+// Removes key and all its values. Returns the removed values.
+func (m MultiMap[K, V]) RemoveAll(key K) []V {
+	values := m[key]
+	delete(m, key)
+	return values
+}
+
+// Gets the count of values of key.
+func (m MultiMap[K, V]) Count(key K) int {
+	return len(m[key])
+}
+
+// Gets the count of values of all keys.
+func (m MultiMap[K, V]) Len() int {
+	count := 0
+	for _, values := range m {
+		count += len(values)
+	}
+
+	return count
+}
+
+// Gets the keys in sorted order.
+func (m MultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessValue(reflect.ValueOf(keys[i]), reflect.ValueOf(keys[j]))
+	})
+
+	return keys
+}
+
+// Gets the count of values of each key.
+func (m MultiMap[K, V]) Counts() map[K]int {
+	result := make(map[K]int, len(m))
+	for key, values := range m {
+		result[key] = len(values)
+	}
+
+	return result
+}
@@ -0,0 +1,57 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestMultiMapPut(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Put("a", 1, 2)
+	m.Put("b", 3)
+	m.Put("a", 4)
+
+	assert.DeepEqual(t, m.GetAll("a"), []int{1, 2, 4})
+	assert.Equal(t, m.Count("a"), 3)
+	assert.Equal(t, m.Len(), 4)
+	assert.Equal(t, m.Has("c"), false)
+	assert.DeepEqual(t, m.Keys(), []string{"a", "b"})
+	assert.DeepEqual(t, m.Counts(), map[string]int{"a": 3, "b": 1})
+
+	m.Put("c")
+	assert.Equal(t, len(m), 2)
+	assert.DeepEqual(t, m.Keys(), []string{"a", "b"})
+}
+
+func TestMultiMapRemove(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Put("a", 1, 2, 1)
+	m.Put("b", 3)
+	values := m.GetAll("a")
+
+	assert.Equal(t, m.Remove("a", 1), true)
+	assert.DeepEqual(t, m.GetAll("a"), []int{2, 1})
+	assert.DeepEqual(t, values, []int{1, 2, 1})
+
+	assert.Equal(t, m.Remove("a", 5), false)
+	assert.Equal(t, m.Remove("b", 3), true)
+	assert.Equal(t, m.Has("b"), false)
+
+	assert.DeepEqual(t, m.RemoveAll("a"), []int{2, 1})
+	assert.Equal(t, len(m), 0)
+}
+
+func ExampleMultiMap() {
+	m := NewMultiMap[string, string]()
+	m.Put("fruit", "apple", "banana")
+	m.Put("vegetable", "carrot")
+
+	for _, key := range m.Keys() {
+		fmt.Println(key, m.GetAll(key))
+	}
+	// Output:
+	// fruit [apple banana]
+	// vegetable [carrot]
+}
@@ -0,0 +1,146 @@
+package godash
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+)
+
+// Option is a value that may be absent. Its zero value is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Creates an Option holding value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// Creates an absent Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Creates an Option from the (value, ok) result of a lookup.
+func OptionOf[T any](value T, ok bool) Option[T] {
+	if ok {
+		return Some(value)
+	}
+
+	return None[T]()
+}
+
+// Checks if the value is present.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// Checks if the value is absent.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Gets the value and whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// Gets the value if it is present, otherwise returns defaultValue.
+func (o Option[T]) OrElse(defaultValue T) T {
+	if o.ok {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// Gets the value if it is present, otherwise returns the result of fn.
+func (o Option[T]) OrElseGet(fn func() T) T {
+	if o.ok {
+		return o.value
+	}
+
+	return fn()
+}
+
+// Returns "Some(value)" or "None".
+func (o Option[T]) String() string {
+	if o.ok {
+		return fmt.Sprintf("Some(%v)", o.value)
+	}
+
+	return "None"
+}
+
+// Creates an Option holding the result of iteratee invoked with the value, or None if the value is absent.
+func MapOption[T any, V any](o Option[T], iteratee Iteratee[T, V]) Option[V] {
+	if o.ok {
+		return Some(iteratee(o.value))
+	}
+
+	return None[V]()
+}
+
+// This method is like Head except that it returns a copy of the first element as an Option.
+func HeadOption[E any](items []E) Option[E] {
+	return NthOption(items, 0)
+}
+
+// This method is like Last except that it returns an Option.
+func LastOption[E any](items []E) Option[E] {
+	return NthOption(items, -1)
+}
+
+// This method is like Nth except that it returns an Option.
+func NthOption[E any](items []E, n int) Option[E] {
+	ok, item := Nth(items, n)
+	return OptionOf(item, ok)
+}
+
+// This method is like Find except that it returns an Option.
+func FindOption[E any](items []E, predicate Predicate[E]) Option[E] {
+	return OptionOf(Find(items, predicate))
+}
+
+// This method is like FindLast except that it returns an Option.
+func FindLastOption[E any](items []E, predicate Predicate[E]) Option[E] {
+	return OptionOf(FindLast(items, predicate))
+}
+
+// This method is like Sample except that it returns an Option.
+func SampleOption[E any](items []E, random *rand.Rand) Option[E] {
+	return OptionOf(Sample(items, random))
+}
+
+// Gets the minimum element of collection, or None if collection is empty.
+func MinOption[E cmp.Ordered](items []E) Option[E] {
+	if len(items) == 0 {
+		return None[E]()
+	}
+
+	result := items[0]
+	for _, item := range items[1:] {
+		if item < result {
+			result = item
+		}
+	}
+
+	return Some(result)
+}
+
+// Gets the maximum element of collection, or None if collection is empty.
+func MaxOption[E cmp.Ordered](items []E) Option[E] {
+	if len(items) == 0 {
+		return None[E]()
+	}
+
+	result := items[0]
+	for _, item := range items[1:] {
+		if item > result {
+			result = item
+		}
+	}
+
+	return Some(result)
+}
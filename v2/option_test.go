@@ -0,0 +1,77 @@
+package godash
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestOption(t *testing.T) {
+	some := Some(1)
+	value, ok := some.Get()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 1)
+	assert.Equal(t, some.IsSome(), true)
+	assert.Equal(t, some.OrElse(2), 1)
+
+	var none Option[int]
+	assert.Equal(t, none.IsNone(), true)
+	assert.Equal(t, none.OrElse(2), 2)
+	assert.Equal(t, none.OrElseGet(func() int { return 3 }), 3)
+	assert.Equal(t, none, None[int]())
+}
+
+func TestMapOption(t *testing.T) {
+	assert.Equal(t, MapOption(Some(12), strconv.Itoa), Some("12"))
+	assert.Equal(t, MapOption(None[int](), strconv.Itoa), None[string]())
+}
+
+func TestHeadLastNthOption(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	assert.Equal(t, HeadOption(items), Some("a"))
+	assert.Equal(t, LastOption(items), Some("c"))
+	assert.Equal(t, NthOption(items, 1), Some("b"))
+	assert.Equal(t, NthOption(items, -2), Some("b"))
+	assert.Equal(t, NthOption(items, 3), None[string]())
+	assert.Equal(t, HeadOption([]string{}), None[string]())
+	assert.Equal(t, LastOption([]string{}), None[string]())
+}
+
+func TestFindOption(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	isOdd := func(i int) bool { return i%2 == 1 }
+
+	assert.Equal(t, FindOption(items, isOdd), Some(1))
+	assert.Equal(t, FindLastOption(items, isOdd), Some(3))
+	assert.Equal(t, FindOption([]int{2}, isOdd), None[int]())
+}
+
+func TestSampleOption(t *testing.T) {
+	items := []int{1, 2, 3}
+	sample, ok := SampleOption(items, nil).Get()
+	assert.Equal(t, ok, true)
+
+	_, found := IndexOf(items, sample)
+	assert.Equal(t, found, true)
+	assert.Equal(t, SampleOption([]int{}, nil).IsNone(), true)
+}
+
+func TestMinMaxOption(t *testing.T) {
+	assert.Equal(t, MinOption([]int{3, 1, 2}), Some(1))
+	assert.Equal(t, MaxOption([]string{"b", "c", "a"}), Some("c"))
+	assert.Equal(t, MinOption([]float64{}), None[float64]())
+	assert.Equal(t, MaxOption([]float64{}), None[float64]())
+}
+
+func ExampleOption() {
+	fmt.Println(MaxOption([]int{4, 8, 2}))
+	fmt.Println(MaxOption([]int{}))
+	fmt.Println(HeadOption([]string{}).OrElse("default"))
+	// Output:
+	// Some(8)
+	// None
+	// default
+}
@@ -0,0 +1,285 @@
+package godash
+
+import (
+	"bytes"
+	"container/list"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that remembers the insertion order of its keys.
+// Setting an existing key updates its value and keeps its position.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+// Creates an empty ordered map.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Creates an ordered map from key-value pairs, in the order of the pairs.
+// This method is like FromPairs except that the order of pairs is kept.
+func NewOrderedMapFromPairs[K comparable, V any](pairs []KeyValuePair[K, V]) *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+	for _, pair := range pairs {
+		result.Set(pair.key, pair.value)
+	}
+
+	return result
+}
+
+func (m *OrderedMap[K, V]) init() {
+	if m.entries == nil {
+		m.entries = make(map[K]*list.Element)
+		m.order = list.New()
+	}
+}
+
+// Sets the value of key. A new key is appended to the end.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.init()
+
+	if element, found := m.entries[key]; found {
+		element.Value = KeyValuePair[K, V]{key, value}
+		return
+	}
+
+	m.entries[key] = m.order.PushBack(KeyValuePair[K, V]{key, value})
+}
+
+// Gets the value of key.
+func (m *OrderedMap[K, V]) Get(key K) (value V, ok bool) {
+	element, ok := m.entries[key]
+	if ok {
+		value = element.Value.(KeyValuePair[K, V]).value
+	}
+
+	return
+}
+
+// Checks if key is in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Deletes key from the map. Returns false if key is not found.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	element, ok := m.entries[key]
+	if ok {
+		m.order.Remove(element)
+		delete(m.entries, key)
+	}
+
+	return ok
+}
+
+// Moves key to the front of the map. Returns false if key is not found.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	element, ok := m.entries[key]
+	if ok {
+		m.order.MoveToFront(element)
+	}
+
+	return ok
+}
+
+// Moves key to the back of the map. Returns false if key is not found.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	element, ok := m.entries[key]
+	if ok {
+		m.order.MoveToBack(element)
+	}
+
+	return ok
+}
+
+// Gets the count of keys in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Iterates over the entries in order. Iteration is stopped once iteratee returns false.
+func (m *OrderedMap[K, V]) Each(iteratee func(key K, value V) bool) {
+	if m.order == nil {
+		return
+	}
+
+	for element := m.order.Front(); element != nil; element = element.Next() {
+		pair := element.Value.(KeyValuePair[K, V])
+		if !iteratee(pair.key, pair.value) {
+			break
+		}
+	}
+}
+
+// Gets the keys in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, 0, m.Len())
+	m.Each(func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// Gets the values in order.
+func (m *OrderedMap[K, V]) Values() []V {
+	result := make([]V, 0, m.Len())
+	m.Each(func(_ K, value V) bool {
+		result = append(result, value)
+		return true
+	})
+
+	return result
+}
+
+// Gets the key-value pairs in order. It is the inverse of NewOrderedMapFromPairs.
+func (m *OrderedMap[K, V]) ToPairs() []KeyValuePair[K, V] {
+	result := make([]KeyValuePair[K, V], 0, m.Len())
+	m.Each(func(key K, value V) bool {
+		result = append(result, KeyValuePair[K, V]{key, value})
+		return true
+	})
+
+	return result
+}
+
+// Marshals the map as a JSON object whose keys are in the map order.
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	var err error
+	first := true
+	m.Each(func(key K, value V) bool {
+		var keyText string
+		if keyText, err = marshalMapKey(key); err != nil {
+			return false
+		}
+
+		var keyData, valueData []byte
+		if keyData, err = json.Marshal(keyText); err != nil {
+			return false
+		}
+
+		if valueData, err = json.Marshal(value); err != nil {
+			return false
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+
+		first = false
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(valueData)
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Unmarshals a JSON object into the map, keeping the order of its keys. JSON null leaves the map unchanged.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("godash: cannot unmarshal %v into OrderedMap", token)
+	}
+
+	m.init()
+	for decoder.More() {
+		if token, err = decoder.Token(); err != nil {
+			return err
+		}
+
+		var key K
+		if err = unmarshalMapKey(token.(string), &key); err != nil {
+			return err
+		}
+
+		var value V
+		if err = decoder.Decode(&value); err != nil {
+			return err
+		}
+
+		m.Set(key, value)
+	}
+
+	_, err = decoder.Token()
+	return err
+}
+
+func marshalMapKey(key any) (string, error) {
+	if marshaler, ok := key.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+
+	value := reflect.ValueOf(key)
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("godash: unsupported map key type %T", key)
+	}
+}
+
+func unmarshalMapKey(text string, key any) error {
+	if unmarshaler, ok := key.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	value := reflect.ValueOf(key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		value.SetUint(n)
+	default:
+		return fmt.Errorf("godash: unsupported map key type %s", value.Type())
+	}
+
+	return nil
+}
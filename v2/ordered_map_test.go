@@ -0,0 +1,148 @@
+package godash
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestOrderedMapSetGet(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	value, ok := m.Get("b")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 3)
+
+	_, ok = m.Get("z")
+	assert.Equal(t, ok, false)
+
+	assert.DeepEqual(t, m.Keys(), []string{"b", "a"})
+	assert.DeepEqual(t, m.Values(), []int{3, 2})
+	assert.Equal(t, m.Len(), 2)
+}
+
+func TestOrderedMapDeleteAndMove(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	for i, key := range []string{"a", "b", "c", "d"} {
+		m.Set(key, i)
+	}
+
+	assert.Equal(t, m.Delete("b"), true)
+	assert.Equal(t, m.Delete("b"), false)
+	assert.Equal(t, m.Has("b"), false)
+
+	assert.Equal(t, m.MoveToFront("d"), true)
+	assert.Equal(t, m.MoveToBack("a"), true)
+	assert.Equal(t, m.MoveToBack("z"), false)
+	assert.DeepEqual(t, m.Keys(), []string{"d", "c", "a"})
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	_, ok := m.Get("a")
+	assert.Equal(t, ok, false)
+
+	m.Set("a", 1)
+	assert.DeepEqual(t, m.Keys(), []string{"a"})
+}
+
+func TestOrderedMapEach(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Set(3, "c")
+	m.Set(1, "a")
+	m.Set(2, "b")
+
+	visited := []int{}
+	m.Each(func(key int, value string) bool {
+		visited = append(visited, key)
+		return key != 1
+	})
+
+	assert.DeepEqual(t, visited, []int{3, 1})
+}
+
+func TestOrderedMapPairs(t *testing.T) {
+	pairs := []KeyValuePair[string, int]{
+		NewKeyValuePair("z", 1),
+		NewKeyValuePair("y", 2),
+		NewKeyValuePair("z", 3),
+	}
+
+	m := NewOrderedMapFromPairs(pairs)
+	assert.DeepEqual(t, m.Keys(), []string{"z", "y"})
+
+	result := m.ToPairs()
+	assert.Equal(t, len(result), 2)
+	assert.Equal(t, result[0].Key(), "z")
+	assert.Equal(t, result[0].Value(), 3)
+	assert.DeepEqual(t, FromPairs(result), map[string]int{"z": 3, "y": 2})
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := NewOrderedMap[string, any]()
+	m.Set("zeta", 1)
+	m.Set("alpha", []int{1, 2})
+	m.Set("mid", map[string]int{"x": 1})
+
+	data, err := json.Marshal(m)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"zeta":1,"alpha":[1,2],"mid":{"x":1}}`)
+
+	decoded := NewOrderedMap[string, json.RawMessage]()
+	err = json.Unmarshal(data, decoded)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, decoded.Keys(), []string{"zeta", "alpha", "mid"})
+
+	data, err = json.Marshal(decoded)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"zeta":1,"alpha":[1,2],"mid":{"x":1}}`)
+}
+
+func TestOrderedMapJSONIntKeys(t *testing.T) {
+	var m OrderedMap[int, string]
+	err := json.Unmarshal([]byte(`{"3":"c","1":"a"}`), &m)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, m.Keys(), []int{3, 1})
+
+	data, err := json.Marshal(&m)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"3":"c","1":"a"}`)
+
+	err = json.Unmarshal([]byte(`{"x":"c"}`), &m)
+	assert.Assert(t, err != nil)
+
+	err = json.Unmarshal([]byte(`[1]`), &m)
+	assert.Assert(t, err != nil)
+}
+
+func TestOrderedMapJSONNull(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	err := json.Unmarshal([]byte(`null`), m)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, m.Keys(), []string{"a"})
+
+	var s struct{ M *OrderedMap[string, int] }
+	err = json.Unmarshal([]byte(`{"M":null}`), &s)
+	assert.NilError(t, err)
+	assert.Assert(t, s.M == nil)
+}
+
+func ExampleOrderedMap() {
+	m := NewOrderedMap[string, int]()
+	m.Set("width", 100)
+	m.Set("height", 50)
+	m.Set("depth", 10)
+	m.MoveToFront("depth")
+
+	data, _ := json.Marshal(m)
+	fmt.Println(string(data))
+	// Output:
+	// {"depth":10,"width":100,"height":50}
+}
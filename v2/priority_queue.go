@@ -0,0 +1,181 @@
+package godash
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// PriorityQueueItem is the handle of a value pushed to a PriorityQueue. It is used to update or remove the value.
+type PriorityQueueItem[E any] struct {
+	value E
+	index int
+}
+
+// Gets the value of the item.
+func (item *PriorityQueueItem[E]) Value() E {
+	return item.value
+}
+
+type priorityHeap[E any] struct {
+	items []*PriorityQueueItem[E]
+	less  Comparison[E]
+}
+
+func (h *priorityHeap[E]) Len() int {
+	return len(h.items)
+}
+
+func (h *priorityHeap[E]) Less(i, j int) bool {
+	return h.less(h.items[i].value, h.items[j].value)
+}
+
+func (h *priorityHeap[E]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *priorityHeap[E]) Push(x any) {
+	item := x.(*PriorityQueueItem[E])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *priorityHeap[E]) Pop() any {
+	last := len(h.items) - 1
+	item := h.items[last]
+	h.items[last] = nil
+	h.items = h.items[:last]
+	item.index = -1
+	return item
+}
+
+// PriorityQueue is a queue backed by a binary heap. The value for which less returns true against all others
+// is popped first, so a less of a < b pops the smallest value first.
+// Create it by NewPriorityQueue. The zero value has no order, so pushing to it panics.
+type PriorityQueue[E any] struct {
+	heap priorityHeap[E]
+}
+
+// Creates a priority queue ordered by less.
+func NewPriorityQueue[E any](less Comparison[E], items ...E) *PriorityQueue[E] {
+	pq := &PriorityQueue[E]{heap: priorityHeap[E]{less: less}}
+	for _, item := range items {
+		pq.heap.items = append(pq.heap.items, &PriorityQueueItem[E]{value: item, index: len(pq.heap.items)})
+	}
+
+	heap.Init(&pq.heap)
+	return pq
+}
+
+// Gets the count of values in the queue.
+func (pq *PriorityQueue[E]) Len() int {
+	return pq.heap.Len()
+}
+
+// Pushes value to the queue and returns its handle. It panics if the queue has no order.
+func (pq *PriorityQueue[E]) Push(value E) *PriorityQueueItem[E] {
+	if pq.heap.less == nil {
+		panic("godash: priority queue must be created by NewPriorityQueue")
+	}
+
+	item := &PriorityQueueItem[E]{value: value}
+	heap.Push(&pq.heap, item)
+	return item
+}
+
+// Removes and returns the value with the highest priority.
+func (pq *PriorityQueue[E]) Pop() (value E, ok bool) {
+	if pq.Len() == 0 {
+		return
+	}
+
+	return heap.Pop(&pq.heap).(*PriorityQueueItem[E]).value, true
+}
+
+// Gets the value with the highest priority without removing it.
+func (pq *PriorityQueue[E]) Peek() (value E, ok bool) {
+	if pq.Len() == 0 {
+		return
+	}
+
+	return pq.heap.items[0].value, true
+}
+
+func (pq *PriorityQueue[E]) contains(item *PriorityQueueItem[E]) bool {
+	return item != nil && item.index >= 0 && item.index < pq.Len() && pq.heap.items[item.index] == item
+}
+
+// Replaces the value of the item and restores the queue order.
+// Returns false if the item is not in the queue.
+func (pq *PriorityQueue[E]) Update(item *PriorityQueueItem[E], value E) bool {
+	if !pq.contains(item) {
+		return false
+	}
+
+	item.value = value
+	heap.Fix(&pq.heap, item.index)
+	return true
+}
+
+// Removes the item from the queue. Returns false if the item is not in the queue.
+func (pq *PriorityQueue[E]) Remove(item *PriorityQueueItem[E]) bool {
+	if !pq.contains(item) {
+		return false
+	}
+
+	heap.Remove(&pq.heap, item.index)
+	return true
+}
+
+// Copies the values into a new slice in heap order, which is not sorted.
+func (pq *PriorityQueue[E]) Slice() []E {
+	result := make([]E, pq.Len())
+	for i, item := range pq.heap.items {
+		result[i] = item.value
+	}
+
+	return result
+}
+
+// Gets k elements with the largest keys, in descending order of the keys. It runs in O(n log k).
+// The iteratee is invoked with one argument: (value).
+func TopK[E any, K cmp.Ordered](items []E, k int, key Iteratee[E, K]) []E {
+	return topKWith(items, k, func(a, b E) bool {
+		return key(a) < key(b)
+	})
+}
+
+// Gets k elements with the smallest keys, in ascending order of the keys. It runs in O(n log k).
+// The iteratee is invoked with one argument: (value).
+func BottomK[E any, K cmp.Ordered](items []E, k int, key Iteratee[E, K]) []E {
+	return topKWith(items, k, func(a, b E) bool {
+		return key(a) > key(b)
+	})
+}
+
+// Keeps the k "largest" elements by less in a heap whose root is the "smallest" kept element.
+func topKWith[E any](items []E, k int, less Comparison[E]) []E {
+	if k <= 0 {
+		return []E{}
+	}
+
+	if k > len(items) {
+		k = len(items)
+	}
+
+	pq := NewPriorityQueue(less, items[:k]...)
+	for _, item := range items[k:] {
+		if root, _ := pq.Peek(); less(root, item) {
+			pq.heap.items[0].value = item
+			heap.Fix(&pq.heap, 0)
+		}
+	}
+
+	result := make([]E, k)
+	for i := k - 1; i >= 0; i-- {
+		result[i], _ = pq.Pop()
+	}
+
+	return result
+}
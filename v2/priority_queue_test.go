@@ -0,0 +1,88 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func lessInt(a, b int) bool {
+	return a < b
+}
+
+func TestPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue(lessInt, 5, 1, 4)
+	pq.Push(3)
+	pq.Push(2)
+
+	top, ok := pq.Peek()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, top, 1)
+
+	result := []int{}
+	for pq.Len() > 0 {
+		item, _ := pq.Pop()
+		result = append(result, item)
+	}
+
+	assert.DeepEqual(t, result, []int{1, 2, 3, 4, 5})
+
+	_, ok = pq.Pop()
+	assert.Equal(t, ok, false)
+}
+
+func TestPriorityQueueUpdateAndRemove(t *testing.T) {
+	pq := NewPriorityQueue[int](lessInt)
+	a := pq.Push(10)
+	b := pq.Push(20)
+	c := pq.Push(30)
+
+	assert.Equal(t, pq.Update(c, 5), true)
+	top, _ := pq.Peek()
+	assert.Equal(t, top, 5)
+	assert.Equal(t, c.Value(), 5)
+
+	assert.Equal(t, pq.Remove(a), true)
+	assert.Equal(t, pq.Remove(a), false)
+	assert.Equal(t, pq.Update(a, 1), false)
+
+	first, _ := pq.Pop()
+	second, _ := pq.Pop()
+	assert.Equal(t, first, 5)
+	assert.Equal(t, second, 20)
+	assert.Equal(t, pq.Remove(b), false)
+}
+
+func TestPriorityQueueZeroValue(t *testing.T) {
+	var pq PriorityQueue[int]
+	_, ok := pq.Pop()
+	assert.Equal(t, ok, false)
+
+	defer func() {
+		assert.Equal(t, recover(), "godash: priority queue must be created by NewPriorityQueue")
+	}()
+
+	pq.Push(1)
+}
+
+func TestTopK(t *testing.T) {
+	items := []int{5, 1, 9, 3, 7, 9, 2}
+	identity := func(i int) int { return i }
+
+	assert.DeepEqual(t, TopK(items, 3, identity), []int{9, 9, 7})
+	assert.DeepEqual(t, BottomK(items, 3, identity), []int{1, 2, 3})
+	assert.DeepEqual(t, TopK(items, 10, identity), []int{9, 9, 7, 5, 3, 2, 1})
+	assert.DeepEqual(t, TopK(items, 0, identity), []int{})
+	assert.DeepEqual(t, items, []int{5, 1, 9, 3, 7, 9, 2})
+}
+
+func ExampleTopK() {
+	words := []string{"go", "lodash", "dash", "godash", "a"}
+	longest := TopK(words, 2, func(s string) int {
+		return len(s)
+	})
+	fmt.Println(longest)
+	// Output:
+	// [lodash godash]
+}
@@ -0,0 +1,151 @@
+package godash
+
+import (
+	"math"
+	"math/rand"
+)
+
+// The random functions accept an optional *rand.Rand. The global math/rand source is used when it is nil,
+// pass rand.New(rand.NewSource(seed)) to get reproducible results.
+
+// Iterator returns the next element and true, or false when there are no more elements.
+type Iterator[E any] func() (E, bool)
+
+// Creates an Iterator over the elements of items.
+func SliceIterator[E any](items []E) Iterator[E] {
+	i := 0
+	return func() (item E, ok bool) {
+		if i < len(items) {
+			item, ok = items[i], true
+			i++
+		}
+
+		return
+	}
+}
+
+func randIntn(random *rand.Rand, n int) int {
+	if random == nil {
+		return rand.Intn(n)
+	}
+
+	return random.Intn(n)
+}
+
+func randFloat64(random *rand.Rand) float64 {
+	if random == nil {
+		return rand.Float64()
+	}
+
+	return random.Float64()
+}
+
+// Gets a random element from collection. Returns false if collection is empty.
+func Sample[E any](items []E, random *rand.Rand) (item E, ok bool) {
+	if len(items) > 0 {
+		item, ok = items[randIntn(random, len(items))], true
+	}
+
+	return
+}
+
+// Gets n random elements at unique keys from collection up to the size of collection. items is not modified.
+func SampleSize[E any](items []E, n int, random *rand.Rand) []E {
+	length := len(items)
+	if n > length {
+		n = length
+	} else if n < 0 {
+		n = 0
+	}
+
+	result := append([]E{}, items...)
+	for i := 0; i < n; i++ {
+		j := i + randIntn(random, length-i)
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result[:n:n]
+}
+
+// Creates an array of shuffled values, using a version of the Fisher-Yates shuffle. items is not modified.
+func Shuffle[E any](items []E, random *rand.Rand) []E {
+	return SampleSize(items, len(items), random)
+}
+
+// Gets a random element from collection, where the chance of each element is proportional to its weight.
+// Elements whose weight is not positive are never chosen. Returns false if no element has a positive weight.
+// The weight iteratee is invoked with one argument: (value).
+func WeightedSample[E any](items []E, weight Iteratee[E, float64], random *rand.Rand) (item E, ok bool) {
+	weights := make([]float64, len(items))
+	total := 0.0
+	for i, el := range items {
+		if w := weight(el); w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+
+	if total <= 0 {
+		return
+	}
+
+	target := randFloat64(random) * total
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+
+		item, ok = items[i], true
+		if target -= w; target < 0 {
+			break
+		}
+	}
+
+	return
+}
+
+// Gets n random elements at unique keys from collection without replacement, where the chance of each element
+// is proportional to its weight. Elements whose weight is not positive are never chosen,
+// so fewer than n elements are returned when there are not enough positive weights.
+// The weight iteratee is invoked with one argument: (value).
+func WeightedSampleSize[E any](items []E, n int, weight Iteratee[E, float64], random *rand.Rand) []E {
+	// Efraimidis-Spirakis: keep the n elements with the largest u^(1/w).
+	keyed := make([]Pair[E, float64], 0, len(items))
+	for _, item := range items {
+		if w := weight(item); w > 0 {
+			keyed = append(keyed, Pair[E, float64]{item, math.Pow(randFloat64(random), 1/w)})
+		}
+	}
+
+	top := TopK(keyed, n, func(pair Pair[E, float64]) float64 {
+		return pair.Second
+	})
+
+	return Map(top, func(pair Pair[E, float64]) E {
+		return pair.First
+	})
+}
+
+// Gets k random elements from a stream of unknown length, reading each element once and keeping at most k
+// elements in memory. Fewer than k elements are returned when the stream is shorter than k.
+func ReservoirSample[E any](next Iterator[E], k int, random *rand.Rand) []E {
+	if k <= 0 {
+		return []E{}
+	}
+
+	reservoir := make([]E, 0, k)
+	for seen := 0; ; seen++ {
+		item, ok := next()
+		if !ok {
+			break
+		}
+
+		if seen < k {
+			reservoir = append(reservoir, item)
+		} else if j := randIntn(random, seen+1); j < k {
+			reservoir[j] = item
+		}
+	}
+
+	return reservoir
+}
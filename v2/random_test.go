@@ -0,0 +1,98 @@
+package godash
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestSample(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	item, ok := Sample(items, nil)
+	assert.Equal(t, ok, true)
+	_, found := IndexOf(items, item)
+	assert.Equal(t, found, true)
+
+	_, ok = Sample([]string{}, nil)
+	assert.Equal(t, ok, false)
+}
+
+func TestSampleSeeded(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	s1 := SampleSize(items, 4, rand.New(rand.NewSource(42)))
+	s2 := SampleSize(items, 4, rand.New(rand.NewSource(42)))
+	assert.DeepEqual(t, s1, s2)
+	assert.Equal(t, len(Uniq(s1)), 4)
+	assert.DeepEqual(t, items, []int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	assert.Equal(t, len(SampleSize(items, 20, nil)), 8)
+	assert.Equal(t, len(SampleSize(items, -1, nil)), 0)
+}
+
+func TestShuffle(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	shuffled := Shuffle(items, rand.New(rand.NewSource(1)))
+
+	assert.DeepEqual(t, shuffled, Shuffle(items, rand.New(rand.NewSource(1))))
+	sort.Ints(shuffled)
+	assert.DeepEqual(t, shuffled, items)
+}
+
+func TestWeightedSample(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	weights := map[string]float64{"never": 0, "rare": 1, "often": 9}
+	items := []string{"never", "rare", "often"}
+	weight := func(s string) float64 { return weights[s] }
+
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		item, ok := WeightedSample(items, weight, random)
+		assert.Equal(t, ok, true)
+		counts[item]++
+	}
+
+	assert.Equal(t, counts["never"], 0)
+	assert.Assert(t, counts["often"] > counts["rare"]*5)
+
+	_, ok := WeightedSample([]string{"never"}, weight, random)
+	assert.Equal(t, ok, false)
+}
+
+func TestWeightedSampleSize(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	items := []int{0, 1, 2, 3, 4}
+	weight := func(i int) float64 { return float64(i) }
+
+	result := WeightedSampleSize(items, 3, weight, random)
+	assert.Equal(t, len(Uniq(result)), 3)
+	_, found := IndexOf(result, 0)
+	assert.Equal(t, found, false)
+
+	assert.Equal(t, len(WeightedSampleSize(items, 10, weight, random)), 4)
+}
+
+func TestReservoirSample(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	random := rand.New(rand.NewSource(5))
+	result := ReservoirSample(SliceIterator(items), 10, random)
+	assert.Equal(t, len(Uniq(result)), 10)
+
+	assert.DeepEqual(t, ReservoirSample(SliceIterator([]int{1, 2}), 5, random), []int{1, 2})
+	assert.DeepEqual(t, ReservoirSample(SliceIterator(items), 0, random), []int{})
+}
+
+func ExampleSampleSize() {
+	random := rand.New(rand.NewSource(1))
+	sample := SampleSize([]string{"a", "b", "c", "d"}, 2, random)
+	fmt.Println(len(sample))
+	// Output:
+	// 2
+}
@@ -0,0 +1,158 @@
+package godash
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// The number of attempts used by Retry when RetryPolicy.MaxAttempts is not set.
+const DefaultRetryAttempts = 3
+
+// Backoff returns the delay to wait before the next attempt. The attempt starts from 1,
+// which is the delay after the first failed attempt.
+type Backoff func(attempt int) time.Duration
+
+// Jitter specifies how randomness is applied to a backoff delay.
+type Jitter int
+
+const (
+	// Uses the backoff delay as is.
+	NoJitter Jitter = iota
+	// Picks a random delay in [0, delay).
+	FullJitter
+	// Keeps half of the backoff delay and picks a random delay in [0, delay/2) for the other half.
+	EqualJitter
+)
+
+// RetryPolicy configures how Retry invokes a function.
+type RetryPolicy struct {
+	// The max number of times the function is invoked. DefaultRetryAttempts is used when it is not positive.
+	MaxAttempts int
+	// Returns the delay before the next attempt. No delay is applied when it is nil.
+	Backoff Backoff
+	// Applies randomness to the backoff delay.
+	Jitter Jitter
+	// Classifies whether an error is retryable. All errors are retryable when it is nil.
+	Retryable Predicate[error]
+	// Invoked before waiting for the next attempt with the failed attempt, its error and the delay.
+	OnRetry func(attempt int, err error, delay time.Duration)
+	// The clock used to wait between attempts. SystemClock is used when it is nil.
+	Clock Clock
+	// The random source used by jitter. The global math/rand source is used when it is nil.
+	Rand *rand.Rand
+}
+
+// Creates a Backoff that always waits for delay.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// Creates a Backoff that waits base * 2^(attempt-1), capped by maxDelay. The delay is not capped when maxDelay is not positive.
+func ExponentialBackoff(base time.Duration, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		if attempt < 1 {
+			attempt = 1
+		}
+
+		delay := float64(base) * math.Pow(2, float64(attempt-1))
+		return capDelay(delay, maxDelay)
+	}
+}
+
+// Creates a Backoff that waits base multiplied by the fibonacci number of the attempt (1, 1, 2, 3, 5...),
+// capped by maxDelay. The delay is not capped when maxDelay is not positive.
+func FibonacciBackoff(base time.Duration, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		prev, cur := 0.0, 1.0
+		for i := 1; i < attempt; i++ {
+			prev, cur = cur, prev+cur
+		}
+
+		return capDelay(float64(base)*cur, maxDelay)
+	}
+}
+
+func capDelay(delay float64, maxDelay time.Duration) time.Duration {
+	if maxDelay > 0 && delay > float64(maxDelay) {
+		return maxDelay
+	} else if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(delay)
+}
+
+func (policy RetryPolicy) delay(attempt int) time.Duration {
+	if policy.Backoff == nil {
+		return 0
+	}
+
+	delay := policy.Backoff(attempt)
+	if delay <= 0 {
+		return 0
+	}
+
+	random := rand.Int63n
+	if policy.Rand != nil {
+		random = policy.Rand.Int63n
+	}
+
+	switch policy.Jitter {
+	case FullJitter:
+		return time.Duration(random(int64(delay)))
+	case EqualJitter:
+		half := delay / 2
+		return half + time.Duration(random(int64(delay-half)))
+	default:
+		return delay
+	}
+}
+
+// Invokes fn until it succeeds, returns a non-retryable error or the max attempts are reached.
+// Between attempts, it waits for the delay computed by the policy backoff and jitter.
+// The error of the last attempt is returned. When ctx is done while waiting, the returned error
+// wraps both ctx.Err() and the last error.
+func Retry(ctx context.Context, fn func(context.Context) error, policy RetryPolicy) error {
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultRetryAttempts
+	}
+
+	clock := policy.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errors.Join(ctxErr, err)
+		}
+
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+
+		if attempt >= maxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+			return err
+		}
+
+		delay := policy.delay(attempt)
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err, delay)
+		}
+
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Join(ctx.Err(), err)
+			case <-clock.After(delay):
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package godash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+var errTemporary = errors.New("temporary")
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(time.Second, 10*time.Second)
+
+	delays := Map([]int{1, 2, 3, 4, 5, 100}, backoff)
+	assert.DeepEqual(t, delays, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second})
+}
+
+func TestFibonacciBackoff(t *testing.T) {
+	backoff := FibonacciBackoff(time.Second, 0)
+
+	delays := Map([]int{1, 2, 3, 4, 5, 6}, backoff)
+	assert.DeepEqual(t, delays, []time.Duration{time.Second, time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second, 8 * time.Second})
+}
+
+func TestRetry(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	attempts := 0
+	delays := []time.Duration{}
+
+	err := Retry(context.Background(), func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errTemporary
+		}
+
+		return nil
+	}, RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     ExponentialBackoff(time.Second, 0),
+		Clock:       clock,
+		OnRetry: func(attempt int, err error, delay time.Duration) {
+			delays = append(delays, delay)
+		},
+	})
+
+	assert.NilError(t, err)
+	assert.Equal(t, attempts, 3)
+	assert.DeepEqual(t, delays, []time.Duration{time.Second, 2 * time.Second})
+	assert.Equal(t, clock.Now(), time.Unix(3, 0))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func(context.Context) error {
+		attempts++
+		return errTemporary
+	}, RetryPolicy{})
+
+	assert.Equal(t, err, errTemporary)
+	assert.Equal(t, attempts, DefaultRetryAttempts)
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	errFatal := errors.New("fatal")
+	attempts := 0
+	err := Retry(context.Background(), func(context.Context) error {
+		attempts++
+		return errFatal
+	}, RetryPolicy{
+		MaxAttempts: 5,
+		Retryable: func(err error) bool {
+			return errors.Is(err, errTemporary)
+		},
+	})
+
+	assert.Equal(t, err, errFatal)
+	assert.Equal(t, attempts, 1)
+}
+
+func TestRetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := Retry(ctx, func(context.Context) error {
+		attempts++
+		cancel()
+		return errTemporary
+	}, RetryPolicy{MaxAttempts: 5})
+
+	assert.Equal(t, attempts, 1)
+	assert.Assert(t, errors.Is(err, context.Canceled))
+	assert.Assert(t, errors.Is(err, errTemporary))
+}
+
+func TestRetryJitter(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		full := RetryPolicy{Backoff: ConstantBackoff(time.Second), Jitter: FullJitter, Rand: random}.delay(1)
+		assert.Assert(t, full >= 0 && full < time.Second)
+
+		equal := RetryPolicy{Backoff: ConstantBackoff(time.Second), Jitter: EqualJitter, Rand: random}.delay(1)
+		assert.Assert(t, equal >= time.Second/2 && equal < time.Second)
+	}
+}
+
+func ExampleRetry() {
+	attempts := 0
+	err := Retry(context.Background(), func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errTemporary
+		}
+
+		return nil
+	}, RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ConstantBackoff(time.Minute),
+		Clock:       NewFakeClock(time.Now()),
+		OnRetry: func(attempt int, err error, delay time.Duration) {
+			fmt.Println(attempt, err, delay)
+		},
+	})
+	fmt.Println(err)
+	// Output:
+	// 1 temporary 1m0s
+	// 2 temporary 1m0s
+	// <nil>
+}
@@ -0,0 +1,118 @@
+package godash
+
+const errRingBufferCapacity = "godash: ring buffer capacity must be positive"
+
+// RingBuffer is a fixed-capacity queue. Once it is full, pushing a new item overwrites the oldest one.
+// Create it by NewRingBuffer. The zero value has no capacity, so pushing to it panics.
+type RingBuffer[E any] struct {
+	items []E
+	head  int
+	count int
+}
+
+// Creates a ring buffer holding at most capacity items. It panics if capacity is not positive.
+func NewRingBuffer[E any](capacity int) *RingBuffer[E] {
+	if capacity <= 0 {
+		panic(errRingBufferCapacity)
+	}
+
+	return &RingBuffer[E]{items: make([]E, capacity)}
+}
+
+func (r *RingBuffer[E]) index(i int) int {
+	return (r.head + i) % len(r.items)
+}
+
+// Gets the count of items in the buffer.
+func (r *RingBuffer[E]) Len() int {
+	return r.count
+}
+
+// Gets the max count of items the buffer holds.
+func (r *RingBuffer[E]) Cap() int {
+	return len(r.items)
+}
+
+// Checks if the buffer is full, so the next push overwrites the oldest item.
+func (r *RingBuffer[E]) IsFull() bool {
+	return len(r.items) > 0 && r.count == len(r.items)
+}
+
+// Appends item as the newest item. When the buffer is full, the oldest item is overwritten and returned.
+// It panics if the buffer has no capacity, i.e. it is not created by NewRingBuffer.
+func (r *RingBuffer[E]) Push(item E) (overwritten E, ok bool) {
+	if len(r.items) == 0 {
+		panic(errRingBufferCapacity)
+	}
+
+	if r.IsFull() {
+		overwritten, ok = r.items[r.head], true
+		r.items[r.head] = item
+		r.head = r.index(1)
+		return
+	}
+
+	r.items[r.index(r.count)] = item
+	r.count++
+	return
+}
+
+// Removes and returns the oldest item.
+func (r *RingBuffer[E]) Pop() (item E, ok bool) {
+	if r.count == 0 {
+		return
+	}
+
+	item, ok = r.items[r.head], true
+
+	var zero E
+	r.items[r.head] = zero
+	r.head = r.index(1)
+	r.count--
+	return
+}
+
+// Gets the oldest item without removing it.
+func (r *RingBuffer[E]) Oldest() (item E, ok bool) {
+	if r.count > 0 {
+		item, ok = r.items[r.head], true
+	}
+
+	return
+}
+
+// Gets the newest item without removing it.
+func (r *RingBuffer[E]) Newest() (item E, ok bool) {
+	if r.count > 0 {
+		item, ok = r.items[r.index(r.count-1)], true
+	}
+
+	return
+}
+
+// Gets the item at index i, where 0 is the oldest. It panics if i is out of range.
+func (r *RingBuffer[E]) At(i int) E {
+	if i < 0 || i >= r.count {
+		panic("godash: ring buffer index out of range")
+	}
+
+	return r.items[r.index(i)]
+}
+
+// Removes all items from the buffer.
+func (r *RingBuffer[E]) Clear() {
+	var zero E
+	Fill(r.items, zero)
+	r.head = 0
+	r.count = 0
+}
+
+// Copies the items from oldest to newest into a new slice, so the array functions can be applied.
+func (r *RingBuffer[E]) Slice() []E {
+	result := make([]E, r.count)
+	for i := range result {
+		result[i] = r.items[r.index(i)]
+	}
+
+	return result
+}
@@ -0,0 +1,85 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestRingBufferPush(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	for i := 1; i <= 3; i++ {
+		_, ok := r.Push(i)
+		assert.Equal(t, ok, false)
+	}
+
+	assert.Equal(t, r.IsFull(), true)
+	overwritten, ok := r.Push(4)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, overwritten, 1)
+
+	assert.DeepEqual(t, r.Slice(), []int{2, 3, 4})
+	assert.Equal(t, r.At(0), 2)
+	assert.Equal(t, r.Len(), 3)
+	assert.Equal(t, r.Cap(), 3)
+
+	oldest, _ := r.Oldest()
+	newest, _ := r.Newest()
+	assert.Equal(t, oldest, 2)
+	assert.Equal(t, newest, 4)
+}
+
+func TestRingBufferPop(t *testing.T) {
+	r := NewRingBuffer[string](2)
+	r.Push("a")
+	r.Push("b")
+	r.Push("c")
+
+	item, ok := r.Pop()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, item, "b")
+
+	r.Push("d")
+	assert.DeepEqual(t, r.Slice(), []string{"c", "d"})
+
+	r.Clear()
+	_, ok = r.Pop()
+	assert.Equal(t, ok, false)
+	assert.DeepEqual(t, r.Slice(), []string{})
+}
+
+func TestRingBufferZeroValue(t *testing.T) {
+	var r RingBuffer[int]
+	assert.Equal(t, r.IsFull(), false)
+	assert.Equal(t, r.Len(), 0)
+
+	_, ok := r.Pop()
+	assert.Equal(t, ok, false)
+	assert.DeepEqual(t, r.Slice(), []int{})
+
+	defer func() {
+		assert.Equal(t, recover(), "godash: ring buffer capacity must be positive")
+	}()
+
+	r.Push(1)
+}
+
+func TestRingBufferInvalidCapacity(t *testing.T) {
+	defer func() {
+		assert.Assert(t, recover() != nil)
+	}()
+
+	NewRingBuffer[int](0)
+}
+
+func ExampleRingBuffer() {
+	recent := NewRingBuffer[int](3)
+	for i := 1; i <= 5; i++ {
+		recent.Push(i)
+	}
+
+	fmt.Println(recent.Slice(), TakeRight(recent.Slice(), 2))
+	// Output:
+	// [3 4 5] [4 5]
+}
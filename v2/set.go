@@ -0,0 +1,203 @@
+package godash
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Set is a collection of unique comparable values. It is a map under the hood,
+// so it can be ranged over with `for v := range set`.
+type Set[T comparable] map[T]struct{}
+
+// Creates a set containing the given items. Use NewSet(slice...) to create a set from a slice.
+func NewSet[T comparable](items ...T) Set[T] {
+	set := make(Set[T], len(items))
+	set.Add(items...)
+	return set
+}
+
+// Adds items to the set.
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Removes items from the set.
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Checks if item is in the set.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s[item]
+	return ok
+}
+
+// Gets the count of items in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Creates a shallow copy of the set.
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for item := range s {
+		result[item] = struct{}{}
+	}
+
+	return result
+}
+
+// Invokes action for each item in the set in an unspecified order.
+func (s Set[T]) Each(action func(T)) {
+	for item := range s {
+		action(item)
+	}
+}
+
+// Creates a set of items that are in either set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone()
+	for item := range other {
+		result[item] = struct{}{}
+	}
+
+	return result
+}
+
+// Creates a set of items that are in both sets.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := Set[T]{}
+	for item := range small {
+		if large.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Creates a set of items that are in this set but not in the other set.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := Set[T]{}
+	for item := range s {
+		if !other.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Creates a set of items that are in exactly one of the sets.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for item := range other {
+		if !s.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Checks if every item of this set is in the other set.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for item := range s {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checks if every item of the other set is in this set.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Checks if both sets contain the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// Converts the set to a sorted slice, so the result can be passed to the array functions.
+// Numbers, strings and bools are sorted by value, other types by their formatted value.
+func (s Set[T]) Slice() []T {
+	result := make([]T, 0, len(s))
+	for item := range s {
+		result = append(result, item)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return lessValue(reflect.ValueOf(result[i]), reflect.ValueOf(result[j]))
+	})
+
+	return result
+}
+
+// Returns a string like "Set[a b c]" with sorted items.
+func (s Set[T]) String() string {
+	return fmt.Sprintf("Set%v", s.Slice())
+}
+
+// Marshals the set as a sorted JSON array.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// Unmarshals a JSON array into the set. Duplicated items are merged.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	if *s == nil {
+		*s = make(Set[T], len(items))
+	}
+
+	s.Add(items...)
+	return nil
+}
+
+func lessValue(a reflect.Value, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && b.IsValid()
+	}
+
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	default:
+		// fmt formats the value held by a reflect.Value, even one obtained from an unexported field.
+		return fmt.Sprintf("%#v", a) < fmt.Sprintf("%#v", b)
+	}
+}
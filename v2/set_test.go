@@ -0,0 +1,103 @@
+package godash
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestSetAddRemove(t *testing.T) {
+	set := NewSet("a", "b", "a")
+	assert.Equal(t, set.Len(), 2)
+	assert.Equal(t, set.Has("a"), true)
+
+	set.Add("c")
+	set.Remove("a", "z")
+	assert.DeepEqual(t, set.Slice(), []string{"b", "c"})
+	assert.Equal(t, set.Has("a"), false)
+}
+
+func TestSetOperations(t *testing.T) {
+	s1 := NewSet(1, 2, 3, 4)
+	s2 := NewSet(3, 4, 5)
+
+	assert.DeepEqual(t, s1.Union(s2).Slice(), []int{1, 2, 3, 4, 5})
+	assert.DeepEqual(t, s1.Intersection(s2).Slice(), []int{3, 4})
+	assert.DeepEqual(t, s1.Difference(s2).Slice(), []int{1, 2})
+	assert.DeepEqual(t, s1.SymmetricDifference(s2).Slice(), []int{1, 2, 5})
+
+	assert.DeepEqual(t, s1.Slice(), []int{1, 2, 3, 4})
+	assert.DeepEqual(t, s2.Slice(), []int{3, 4, 5})
+}
+
+func TestSetSubset(t *testing.T) {
+	s1 := NewSet(1, 2)
+	s2 := NewSet(1, 2, 3)
+
+	assert.Equal(t, s1.IsSubset(s2), true)
+	assert.Equal(t, s2.IsSubset(s1), false)
+	assert.Equal(t, s2.IsSuperset(s1), true)
+	assert.Equal(t, NewSet[int]().IsSubset(s1), true)
+	assert.Equal(t, s1.Equal(NewSet(2, 1)), true)
+	assert.Equal(t, s1.Equal(s2), false)
+}
+
+func TestSetSliceSorted(t *testing.T) {
+	set := NewSet(10, 9, -1, 100)
+	assert.DeepEqual(t, set.Slice(), []int{-1, 9, 10, 100})
+
+	mixed := NewSet[any]("b", 2, "a", 1)
+	assert.DeepEqual(t, mixed.Slice(), []any{1, 2, "a", "b"})
+}
+
+func TestSetWithArrayFunctions(t *testing.T) {
+	set := NewSet(Concat([]string{"a", "b"}, []string{"b", "c"})...)
+	result := Map(set.Slice(), ToUpper)
+
+	assert.DeepEqual(t, result, []string{"A", "B", "C"})
+}
+
+func TestSetJSON(t *testing.T) {
+	set := NewSet(3, 1, 2)
+	data, err := json.Marshal(set)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), "[1,2,3]")
+
+	var decoded Set[int]
+	err = json.Unmarshal([]byte("[5,4,5]"), &decoded)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, decoded.Slice(), []int{4, 5})
+
+	err = json.Unmarshal([]byte(`{"a":1}`), &decoded)
+	assert.Assert(t, err != nil)
+}
+
+func TestSetJSONField(t *testing.T) {
+	type doc struct {
+		Tags Set[string] `json:"tags"`
+	}
+
+	var d doc
+	err := json.Unmarshal([]byte(`{"tags":["go","dash","go"]}`), &d)
+	assert.NilError(t, err)
+	assert.Equal(t, d.Tags.Len(), 2)
+
+	data, err := json.Marshal(d)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"tags":["dash","go"]}`)
+}
+
+func ExampleSet() {
+	s1 := NewSet("a", "b", "c")
+	s2 := NewSet("b", "c", "d")
+
+	fmt.Println(s1.Union(s2))
+	fmt.Println(s1.Intersection(s2))
+	fmt.Println(s1.SymmetricDifference(s2))
+	// Output:
+	// Set[a b c d]
+	// Set[b c]
+	// Set[a d]
+}
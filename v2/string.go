@@ -4,6 +4,8 @@ import (
 	"html"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -11,55 +13,21 @@ import (
 
 type InitCamelCase bool
 
-// Converts string to camel case. First char is lower case.
-func CamelCase(str string) (string, error) {
+// Converts string to camel case, e.g. "foo_bar" to "fooBar". First char is lower case.
+func CamelCase(str string) string {
 	return CamelCaseWithInit(str, false)
 }
 
-// Converts string to camel case. First char is lower case by default.
-func CamelCaseWithInit(str string, upperCase InitCamelCase) (string, error) {
-	initCamelCaseFunc := Ternary(bool(upperCase), strings.ToUpper, strings.ToLower).(func(string) string)
-
-	str, err := replaceWithRegexFunc(`\s(.)`, str, strings.ToUpper)
-	if err != nil {
-		return "", err
-	}
-
-	if str, err = replaceWithRegexFunc(`([\d.,\-_])[a-zA-Z]`, str, strings.ToUpper); err != nil {
-		return "", err
-	}
-
-	if str, err = replaceWithRegex(`\s`, str, ""); err != nil {
-		return "", err
-	}
-
-	if str, err = replaceWithRegexFunc(`^(.)`, str, initCamelCaseFunc); err != nil {
-		return "", err
-	}
-
-	return str, nil
-}
-
-func replaceWithRegex(expression string, src string, repl string) (string, error) {
-	r, err := regexp.Compile(expression)
-	if err != nil {
-		return "", err
-	}
-
-	result := src
-	result = r.ReplaceAllString(result, repl)
-	return result, nil
-}
-
-func replaceWithRegexFunc(expression string, src string, repl func(string) string) (string, error) {
-	r, err := regexp.Compile(expression)
-	if err != nil {
-		return "", err
+// Converts string to camel case. First char is lower case by default, and upper case like PascalCase when upperCase
+// is true. The string is split by Words, and each word is capitalized, e.g. "HTTPServerID2" to "httpServerId2".
+func CamelCaseWithInit(str string, upperCase InitCamelCase) string {
+	str = PascalCase(str)
+	if upperCase || str == "" {
+		return str
 	}
 
-	result := src
-	result = r.ReplaceAllStringFunc(result, repl)
-	return result, nil
+	r, size := utf8.DecodeRuneInString(str)
+	return string(unicode.ToLower(r)) + str[size:]
 }
 
 // Converts the first character of string to upper case and the remaining to lower case.
@@ -281,7 +249,202 @@ func UpperFirst(str string) string {
 	}
 }
 
-//TODO: kebabCase(str string) string
-//TODO: lowerCase(str string) string
-//TODO: snakeCase(str string) string
-//TODO: words
+type runeClass int
+
+const (
+	separatorRune runeClass = iota
+	upperRune
+	lowerRune
+	otherLetterRune
+	digitRune
+	markRune
+	apostropheRune
+)
+
+var apostropheReplacer = strings.NewReplacer("'", "", "\u2019", "")
+
+func classifyRune(r rune) runeClass {
+	switch {
+	case unicode.IsUpper(r) || unicode.IsTitle(r):
+		return upperRune
+	case unicode.IsLower(r) || unicode.Is(unicode.Lm, r):
+		return lowerRune
+	case unicode.IsLetter(r):
+		return otherLetterRune
+	case unicode.IsNumber(r):
+		return digitRune
+	case unicode.IsMark(r):
+		return markRune
+	case r == '\'' || r == '\u2019':
+		return apostropheRune
+	default:
+		return separatorRune
+	}
+}
+
+func isLetterClass(class runeClass) bool {
+	return class == upperRune || class == lowerRune || class == otherLetterRune
+}
+
+// Splits string into an array of its words. Words are split at separators, case transitions, the end of acronyms,
+// digits and changes between cased and uncased scripts, e.g. "XMLHttpRequest2" into XML, Http, Request and 2.
+// Combining marks stay with their letters, and contractions and ordinals such as "don't" and "1st" are single words.
+func Words(str string) []string {
+	runes := []rune(str)
+	classes := Map(runes, classifyRune)
+	words := []string{}
+	start := -1
+
+	flush := func(end int) {
+		if start >= 0 {
+			words = append(words, string(runes[start:end]))
+			start = -1
+		}
+	}
+
+	for i := range classes {
+		if !isWordRune(classes, i, start >= 0) {
+			flush(i)
+			continue
+		}
+
+		if start >= 0 && isWordBoundary(runes, classes, i) {
+			flush(i)
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	flush(len(runes))
+	return words
+}
+
+// This method is like Words except that words are the matches of pattern.
+func WordsWithPattern(str string, pattern *regexp.Regexp) []string {
+	words := pattern.FindAllString(str, -1)
+	if words == nil {
+		return []string{}
+	}
+
+	return words
+}
+
+// Checks if runes[i] belongs to a word. Marks only belong to a word they follow,
+// and apostrophes only join letters to following lower case letters.
+func isWordRune(classes []runeClass, i int, inWord bool) bool {
+	switch classes[i] {
+	case separatorRune:
+		return false
+	case markRune:
+		return inWord
+	case apostropheRune:
+		return inWord && isLetterClass(classes[i-1]) && i+1 < len(classes) && classes[i+1] == lowerRune
+	default:
+		return true
+	}
+}
+
+// Checks if a word starts at runes[i], given that a word is in progress.
+func isWordBoundary(runes []rune, classes []runeClass, i int) bool {
+	current := classes[i]
+	if current == markRune || current == apostropheRune {
+		return false
+	}
+
+	prevIndex := i - 1
+	for classes[prevIndex] == markRune || classes[prevIndex] == apostropheRune {
+		prevIndex--
+	}
+
+	prev := classes[prevIndex]
+	switch {
+	case prev == digitRune && current != digitRune:
+		return !isOrdinalSuffix(runes, classes, i)
+	case prev != digitRune && current == digitRune:
+		return true
+	case (prev == otherLetterRune) != (current == otherLetterRune):
+		return true
+	case prev == lowerRune && current == upperRune:
+		return true
+	case prev == upperRune && current == upperRune:
+		// The last upper case letter of an acronym starts the next word, e.g. "Http" in "XMLHttp".
+		next := i + 1
+		for next < len(classes) && classes[next] == markRune {
+			next++
+		}
+
+		return next < len(classes) && classes[next] == lowerRune
+	default:
+		return false
+	}
+}
+
+// Checks if runes[i:] starts with an ordinal suffix "st", "nd", "rd" or "th" that ends the word.
+func isOrdinalSuffix(runes []rune, classes []runeClass, i int) bool {
+	if i+2 > len(runes) || i+2 < len(runes) && isLetterClass(classes[i+2]) {
+		return false
+	}
+
+	switch strings.ToLower(string(runes[i : i+2])) {
+	case "st", "nd", "rd", "th":
+		return true
+	default:
+		return false
+	}
+}
+
+func joinWords(str string, separator string, convert func(word string) string) string {
+	words := Words(str)
+	for i, word := range words {
+		words[i] = convert(apostropheReplacer.Replace(word))
+	}
+
+	return strings.Join(words, separator)
+}
+
+func upperFirstRune(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+func upperFirstLowerRest(word string) string {
+	return upperFirstRune(strings.ToLower(word))
+}
+
+// Converts string to kebab case, e.g. "fooBar" to "foo-bar".
+func KebabCase(str string) string {
+	return joinWords(str, "-", strings.ToLower)
+}
+
+// Converts string to snake case, e.g. "fooBar" to "foo_bar".
+func SnakeCase(str string) string {
+	return joinWords(str, "_", strings.ToLower)
+}
+
+// Converts string to screaming snake case, e.g. "fooBar" to "FOO_BAR".
+func ScreamingSnakeCase(str string) string {
+	return joinWords(str, "_", strings.ToUpper)
+}
+
+// Converts string to start case, e.g. "fooBar" to "Foo Bar". Only the first letter of each word is changed,
+// so "__FOO_BAR__" is converted to "FOO BAR".
+func StartCase(str string) string {
+	return joinWords(str, " ", upperFirstRune)
+}
+
+// Converts string, as space separated words, to lower case, e.g. "fooBar" to "foo bar".
+func LowerCase(str string) string {
+	return joinWords(str, " ", strings.ToLower)
+}
+
+// Converts string, as space separated words, to upper case, e.g. "fooBar" to "FOO BAR".
+func UpperCase(str string) string {
+	return joinWords(str, " ", strings.ToUpper)
+}
+
+// Converts string to pascal case, e.g. "foo_bar" to "FooBar" and "HTTPServerID2" to "HttpServerId2".
+func PascalCase(str string) string {
+	return joinWords(str, "", upperFirstLowerRest)
+}
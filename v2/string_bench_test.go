@@ -0,0 +1,56 @@
+package godash
+
+import (
+	"strconv"
+	"testing"
+)
+
+func benchmarkIdentifiers(n int) []string {
+	forms := []string{"hello world from gin", "HTTPServerID", "foo_bar-baz", "XMLHttpRequest", "user id"}
+	items := make([]string, n)
+	for i := range items {
+		items[i] = forms[i%len(forms)] + strconv.Itoa(i)
+	}
+
+	return items
+}
+
+func benchmarkCaseFunc(b *testing.B, convert func(string) string) {
+	items := benchmarkIdentifiers(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, item := range items {
+			convert(item)
+		}
+	}
+}
+
+func BenchmarkCamelCase(b *testing.B) {
+	benchmarkCaseFunc(b, CamelCase)
+}
+
+func BenchmarkPascalCase(b *testing.B) {
+	benchmarkCaseFunc(b, PascalCase)
+}
+
+func BenchmarkKebabCase(b *testing.B) {
+	benchmarkCaseFunc(b, KebabCase)
+}
+
+func BenchmarkSnakeCase(b *testing.B) {
+	benchmarkCaseFunc(b, SnakeCase)
+}
+
+func BenchmarkWords(b *testing.B) {
+	items := benchmarkIdentifiers(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, item := range items {
+			Words(item)
+		}
+	}
+}
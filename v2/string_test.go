@@ -1,46 +1,61 @@
 package godash
 
 import (
-	"gotest.tools/assert"
+	"fmt"
+	"regexp"
 	"testing"
+
+	"gotest.tools/assert"
 )
 
 func TestCamelCase(t *testing.T) {
 	str := "Hello world"
-	str, _ = CamelCase(str)
+	str = CamelCase(str)
 
 	assert.Equal(t, str, "helloWorld")
 
-	str, _ = CamelCase("Hello World from Ginkgo")
+	str = CamelCase("Hello World from Ginkgo")
 	assert.Equal(t, str, "helloWorldFromGinkgo")
 
-	str, _ = CamelCase("001hello from gin")
+	str = CamelCase("001hello from gin")
 	assert.Equal(t, str, "001HelloFromGin")
 
-	str, _ = CamelCase("0aa1bb2cc")
+	str = CamelCase("0aa1bb2cc")
 	assert.Equal(t, str, "0Aa1Bb2Cc")
 
-	str, _ = CamelCase("aa-bb-cc")
-	assert.Equal(t, str, "aa-Bb-Cc")
+	str = CamelCase("aa-bb-cc")
+	assert.Equal(t, str, "aaBbCc")
+
+	str = CamelCase("HTTPServerID2")
+	assert.Equal(t, str, "httpServerId2")
 }
 
 func TestCamelCaseWithInit(t *testing.T) {
 	str := "Hello world"
-	str, _ = CamelCaseWithInit(str, true)
+	str = CamelCaseWithInit(str, true)
 
 	assert.Equal(t, str, "HelloWorld")
 
-	str, _ = CamelCaseWithInit("Hello World from Ginkgo", true)
+	str = CamelCaseWithInit("Hello World from Ginkgo", true)
 	assert.Equal(t, str, "HelloWorldFromGinkgo")
 
-	str, _ = CamelCaseWithInit("001hello from gin", true)
+	str = CamelCaseWithInit("001hello from gin", true)
 	assert.Equal(t, str, "001HelloFromGin")
 
-	str, _ = CamelCaseWithInit("0aa1bb2cc", true)
+	str = CamelCaseWithInit("0aa1bb2cc", true)
 	assert.Equal(t, str, "0Aa1Bb2Cc")
 
-	str, _ = CamelCaseWithInit("aa-bb-cc", true)
-	assert.Equal(t, str, "Aa-Bb-Cc")
+	str = CamelCaseWithInit("aa-bb-cc", true)
+	assert.Equal(t, str, "AaBbCc")
+
+	str = CamelCaseWithInit("foo_bar", true)
+	assert.Equal(t, str, PascalCase("foo_bar"))
+}
+
+func TestCamelCaseUnicode(t *testing.T) {
+	assert.Equal(t, CamelCase(""), "")
+	assert.Equal(t, CamelCase("Élan vital"), "élanVital")
+	assert.Equal(t, CamelCaseWithInit("élan-été", true), "ÉlanÉté")
 }
 
 func TestCapitalize(t *testing.T) {
@@ -218,3 +233,85 @@ func TestUpperFirst(t *testing.T) {
 	str = UpperFirst("FRED")
 	assert.Equal(t, str, "FRED")
 }
+
+func TestCaseConversions(t *testing.T) {
+	cases := []struct {
+		str                                                  string
+		kebab, snake, screaming, start, lower, upper, pascal string
+	}{
+		{"HTTPServerID2", "http-server-id-2", "http_server_id_2", "HTTP_SERVER_ID_2", "HTTP Server ID 2", "http server id 2", "HTTP SERVER ID 2", "HttpServerId2"},
+		{"foo_bar-baz", "foo-bar-baz", "foo_bar_baz", "FOO_BAR_BAZ", "Foo Bar Baz", "foo bar baz", "FOO BAR BAZ", "FooBarBaz"},
+		{"fooBar", "foo-bar", "foo_bar", "FOO_BAR", "Foo Bar", "foo bar", "FOO BAR", "FooBar"},
+		{"__FOO_BAR__", "foo-bar", "foo_bar", "FOO_BAR", "FOO BAR", "foo bar", "FOO BAR", "FooBar"},
+		{"don't stop", "dont-stop", "dont_stop", "DONT_STOP", "Dont Stop", "dont stop", "DONT STOP", "DontStop"},
+		{"élan vital", "élan-vital", "élan_vital", "ÉLAN_VITAL", "Élan Vital", "élan vital", "ÉLAN VITAL", "ÉlanVital"},
+		{"", "", "", "", "", "", "", ""},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, KebabCase(c.str), c.kebab)
+		assert.Equal(t, SnakeCase(c.str), c.snake)
+		assert.Equal(t, ScreamingSnakeCase(c.str), c.screaming)
+		assert.Equal(t, StartCase(c.str), c.start)
+		assert.Equal(t, LowerCase(c.str), c.lower)
+		assert.Equal(t, UpperCase(c.str), c.upper)
+		assert.Equal(t, PascalCase(c.str), c.pascal)
+	}
+}
+
+func ExampleKebabCase() {
+	fmt.Println(KebabCase("HTTPServerID2"))
+	fmt.Println(SnakeCase("fooBar"))
+	fmt.Println(ScreamingSnakeCase("foo-bar"))
+	// Output:
+	// http-server-id-2
+	// foo_bar
+	// FOO_BAR
+}
+
+func TestWords(t *testing.T) {
+	cases := []struct {
+		str      string
+		expected []string
+	}{
+		{"fred, barney, & pebbles", []string{"fred", "barney", "pebbles"}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"HTTPServerID2", []string{"HTTP", "Server", "ID", "2"}},
+		{"foo_bar-baz qux", []string{"foo", "bar", "baz", "qux"}},
+		{"v2beta10", []string{"v", "2", "beta", "10"}},
+		{"the 1st and 22ND place", []string{"the", "1st", "and", "22ND", "place"}},
+		{"don't stop 'quoted' O'Neil", []string{"don't", "stop", "quoted", "O", "Neil"}},
+		{"café noir", []string{"café", "noir"}},
+		{"caféNoir", []string{"café", "Noir"}},
+		{"ÉcoleNormale", []string{"École", "Normale"}},
+		{"straßeМосква", []string{"straße", "Москва"}},
+		{"東京Tower大阪", []string{"東京", "Tower", "大阪"}},
+		{"", []string{}},
+		{"--", []string{}},
+	}
+
+	for _, c := range cases {
+		assert.DeepEqual(t, Words(c.str), c.expected)
+	}
+}
+
+func TestWordsWithPattern(t *testing.T) {
+	pattern := regexp.MustCompile(`[^, ]+`)
+	assert.DeepEqual(t, WordsWithPattern("fred, barney, & pebbles", pattern), []string{"fred", "barney", "&", "pebbles"})
+	assert.DeepEqual(t, WordsWithPattern(", ,", pattern), []string{})
+}
+
+func TestCaseConversionsWithUnicode(t *testing.T) {
+	assert.Equal(t, KebabCase("caféNoir"), "café-noir")
+	assert.Equal(t, SnakeCase("東京Tower"), "東京_tower")
+	assert.Equal(t, KebabCase("the 1st place"), "the-1st-place")
+	assert.Equal(t, PascalCase("XMLHttpRequest"), "XmlHttpRequest")
+}
+
+func ExampleWords() {
+	fmt.Printf("%q\n", Words("XMLHttpRequest"))
+	fmt.Printf("%q\n", Words("fred, barney, & pebbles"))
+	// Output:
+	// ["XML" "Http" "Request"]
+	// ["fred" "barney" "pebbles"]
+}
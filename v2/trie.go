@@ -0,0 +1,187 @@
+package godash
+
+import (
+	"sort"
+)
+
+type trieNode[V any] struct {
+	children map[byte]*trieNode[V]
+	value    V
+	terminal bool
+}
+
+// Trie is a prefix tree keyed by strings. Keys are split by bytes, so any string, including invalid UTF-8, is
+// a distinct key. Keys are visited in byte order, which is the order of their runes for valid UTF-8.
+// The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+	root  trieNode[V]
+	count int
+}
+
+// Creates an empty trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Creates a trie from strings. The value of each key is the index of its first occurrence in items.
+func NewTrieFromSlice(items []string) *Trie[int] {
+	trie := NewTrie[int]()
+	for i, item := range items {
+		if !trie.Has(item) {
+			trie.Insert(item, i)
+		}
+	}
+
+	return trie
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	node := &t.root
+	for i := 0; i < len(key); i++ {
+		if node = node.children[key[i]]; node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// Inserts key with value. The value of an existing key is replaced.
+func (t *Trie[V]) Insert(key string, value V) {
+	node := &t.root
+	for i := 0; i < len(key); i++ {
+		child := node.children[key[i]]
+		if child == nil {
+			if node.children == nil {
+				node.children = make(map[byte]*trieNode[V])
+			}
+
+			child = &trieNode[V]{}
+			node.children[key[i]] = child
+		}
+
+		node = child
+	}
+
+	if !node.terminal {
+		node.terminal = true
+		t.count++
+	}
+
+	node.value = value
+}
+
+// Gets the value of key.
+func (t *Trie[V]) Get(key string) (value V, ok bool) {
+	if node := t.find(key); node != nil && node.terminal {
+		value, ok = node.value, true
+	}
+
+	return
+}
+
+// Checks if key is in the trie.
+func (t *Trie[V]) Has(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Deletes key from the trie. Returns false if key is not found.
+func (t *Trie[V]) Delete(key string) bool {
+	path := []*trieNode[V]{&t.root}
+	for i := 0; i < len(key); i++ {
+		node := path[len(path)-1].children[key[i]]
+		if node == nil {
+			return false
+		}
+
+		path = append(path, node)
+	}
+
+	node := path[len(path)-1]
+	if !node.terminal {
+		return false
+	}
+
+	var zero V
+	node.terminal = false
+	node.value = zero
+	t.count--
+
+	for i := len(key) - 1; i >= 0; i-- {
+		child := path[i+1]
+		if child.terminal || len(child.children) > 0 {
+			break
+		}
+
+		delete(path[i].children, key[i])
+	}
+
+	return true
+}
+
+// Gets the count of keys in the trie.
+func (t *Trie[V]) Len() int {
+	return t.count
+}
+
+// Iterates over the keys starting with prefix in sorted order. Iteration is stopped once iteratee returns false.
+func (t *Trie[V]) WithPrefix(prefix string, iteratee func(key string, value V) bool) {
+	if node := t.find(prefix); node != nil {
+		walkTrie(node, []byte(prefix), iteratee)
+	}
+}
+
+func walkTrie[V any](node *trieNode[V], key []byte, iteratee func(string, V) bool) bool {
+	if node.terminal && !iteratee(string(key), node.value) {
+		return false
+	}
+
+	bytes := make([]byte, 0, len(node.children))
+	for b := range node.children {
+		bytes = append(bytes, b)
+	}
+
+	sort.Slice(bytes, func(i, j int) bool {
+		return bytes[i] < bytes[j]
+	})
+
+	for _, b := range bytes {
+		if !walkTrie(node.children[b], append(key, b), iteratee) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Gets the keys starting with prefix in sorted order.
+func (t *Trie[V]) KeysWithPrefix(prefix string) []string {
+	result := []string{}
+	t.WithPrefix(prefix, func(key string, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// Gets the longest key that is a prefix of str.
+func (t *Trie[V]) LongestPrefixOf(str string) (key string, value V, ok bool) {
+	node := &t.root
+	if node.terminal {
+		value, ok = node.value, true
+	}
+
+	for i := 0; i < len(str); i++ {
+		if node = node.children[str[i]]; node == nil {
+			break
+		}
+
+		if node.terminal {
+			key, value, ok = str[:i+1], node.value, true
+		}
+	}
+
+	return
+}
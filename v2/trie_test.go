@@ -0,0 +1,103 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestTrieInsertGet(t *testing.T) {
+	trie := NewTrie[int]()
+	trie.Insert("go", 1)
+	trie.Insert("godash", 2)
+	trie.Insert("go", 3)
+
+	value, ok := trie.Get("go")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, value, 3)
+
+	_, ok = trie.Get("god")
+	assert.Equal(t, ok, false)
+	assert.Equal(t, trie.Len(), 2)
+}
+
+func TestTrieDelete(t *testing.T) {
+	var trie Trie[bool]
+	trie.Insert("go", true)
+	trie.Insert("godash", true)
+
+	assert.Equal(t, trie.Delete("god"), false)
+	assert.Equal(t, trie.Delete("godash"), true)
+	assert.Equal(t, trie.Delete("godash"), false)
+	assert.Equal(t, len(trie.find("go").children), 0)
+	assert.Equal(t, trie.Has("go"), true)
+	assert.Equal(t, trie.Len(), 1)
+}
+
+func TestTrieWithPrefix(t *testing.T) {
+	trie := NewTrieFromSlice([]string{"banana", "apple", "apricot", "avocado", "apple"})
+
+	assert.DeepEqual(t, trie.KeysWithPrefix("ap"), []string{"apple", "apricot"})
+	assert.DeepEqual(t, trie.KeysWithPrefix(""), []string{"apple", "apricot", "avocado", "banana"})
+	assert.DeepEqual(t, trie.KeysWithPrefix("c"), []string{})
+
+	index, _ := trie.Get("apple")
+	assert.Equal(t, index, 1)
+
+	visited := []string{}
+	trie.WithPrefix("a", func(key string, _ int) bool {
+		visited = append(visited, key)
+		return len(visited) < 2
+	})
+	assert.DeepEqual(t, visited, []string{"apple", "apricot"})
+}
+
+func TestTrieUnicode(t *testing.T) {
+	trie := NewTrieFromSlice([]string{"北京", "北京市", "北海", "上海"})
+
+	assert.DeepEqual(t, trie.KeysWithPrefix("北"), []string{"北京", "北京市", "北海"})
+
+	key, value, ok := trie.LongestPrefixOf("北京市朝阳区")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, key, "北京市")
+	assert.Equal(t, value, 1)
+}
+
+func TestTrieInvalidUTF8(t *testing.T) {
+	trie := NewTrieFromSlice([]string{"a\xff", "a\xfe", "a\uFFFD"})
+
+	assert.Equal(t, trie.Len(), 3)
+	assert.DeepEqual(t, trie.KeysWithPrefix("a"), []string{"a\xef\xbf\xbd", "a\xfe", "a\xff"})
+
+	value, _ := trie.Get("a\xfe")
+	assert.Equal(t, value, 1)
+	assert.Equal(t, trie.Delete("a\xff"), true)
+	assert.Equal(t, trie.Has("a\xfe"), true)
+}
+
+func TestTrieLongestPrefixOf(t *testing.T) {
+	trie := NewTrie[string]()
+	trie.Insert("/api", "api")
+	trie.Insert("/api/users", "users")
+
+	key, value, ok := trie.LongestPrefixOf("/api/users/1")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, key, "/api/users")
+	assert.Equal(t, value, "users")
+
+	key, _, _ = trie.LongestPrefixOf("/api/orders")
+	assert.Equal(t, key, "/api")
+
+	_, _, ok = trie.LongestPrefixOf("/home")
+	assert.Equal(t, ok, false)
+}
+
+func ExampleTrie() {
+	products := []string{"gopher plush", "golang book", "go mug", "lodash sticker"}
+	trie := NewTrieFromSlice(products)
+
+	fmt.Println(trie.KeysWithPrefix("go"))
+	// Output:
+	// [go mug golang book gopher plush]
+}